@@ -0,0 +1,129 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	cacheResults = prometheus.NewDesc(
+		"trafficserver_cache_results_total",
+		"Client transactions by cache lookup result. The kinds are disjoint, fresh only counts hits served from disk.",
+		[]string{"result", "kind"}, nil,
+	)
+	cacheVolumeFragsPerDoc = prometheus.NewDesc(
+		"trafficserver_cache_volume_frags_per_doc_total",
+		"Documents in the cache volume by number of fragments.",
+		[]string{"volume", "frags"}, nil,
+	)
+	cacheVolumeRAMCacheLookups = prometheus.NewDesc(
+		"trafficserver_cache_volume_ram_cache_lookups_total",
+		"RAM cache lookups in the cache volume by result.",
+		[]string{"volume", "result"}, nil,
+	)
+
+	cacheVolumeRecord = regexp.MustCompile(`^proxy\.process\.cache\.volume_([0-9]+)\.(.+)$`)
+)
+
+// cacheResultRecords maps the cache result records to their result and kind
+// labels.
+var cacheResultRecords = map[string][2]string{
+	"proxy.process.http.cache_hit_fresh":                 {"hit", "fresh"},
+	"proxy.process.http.cache_hit_mem_fresh":             {"hit", "mem_fresh"},
+	"proxy.process.http.cache_hit_revalidated":           {"hit", "revalidated"},
+	"proxy.process.http.cache_hit_ims":                   {"hit", "ims"},
+	"proxy.process.http.cache_hit_stale_served":          {"hit", "stale_served"},
+	"proxy.process.http.cache_miss_cold":                 {"miss", "cold"},
+	"proxy.process.http.cache_miss_changed":              {"miss", "changed"},
+	"proxy.process.http.cache_miss_client_no_cache":      {"miss", "client_no_cache"},
+	"proxy.process.http.cache_miss_client_not_cacheable": {"miss", "client_not_cacheable"},
+	"proxy.process.http.cache_miss_ims":                  {"miss", "ims"},
+	"proxy.process.http.cache_read_error":                {"error", "read"},
+}
+
+// collectCacheResults folds the cache result records into one labeled metric
+// so that a hit ratio is a single sum by result.
+func collectCacheResults(records map[string]float64, ch chan<- prometheus.Metric) {
+	// ATS counts RAM cache hits in both cache_hit_mem_fresh and
+	// cache_hit_fresh, take them out of the latter so sums don't count them
+	// twice.
+	fresh, hasFresh := records["proxy.process.http.cache_hit_fresh"]
+	mem, hasMem := records["proxy.process.http.cache_hit_mem_fresh"]
+	if hasFresh && hasMem && fresh >= mem {
+		records["proxy.process.http.cache_hit_fresh"] = fresh - mem
+	}
+
+	for record, labels := range cacheResultRecords {
+		value, ok := records[record]
+		if !ok {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(cacheResults, prometheus.CounterValue, value, labels[0], labels[1])
+		delete(records, record)
+	}
+}
+
+// collectCacheVolumes exports the proxy.process.cache.volume_N records with a
+// volume label, for however many volumes are configured in volume.config.
+// The record name without the volume makes up the metric name, e.g.
+// proxy.process.cache.volume_0.read.success becomes
+// trafficserver_cache_volume_read_success_total{volume="0"}, unless the
+// total has a curated name to follow.
+func collectCacheVolumes(records map[string]float64, ch chan<- prometheus.Metric) {
+	for record, value := range records {
+		m := cacheVolumeRecord.FindStringSubmatch(record)
+		if m == nil {
+			continue
+		}
+		volume, stat := m[1], m[2]
+
+		// frags_per_doc.1, .2 and .3+ would all sanitize to about the
+		// same name, so the fragment count goes into a label.
+		if strings.HasPrefix(stat, "frags_per_doc.") {
+			frags := strings.TrimPrefix(stat, "frags_per_doc.")
+			ch <- prometheus.MustNewConstMetric(cacheVolumeFragsPerDoc, prometheus.CounterValue, value, volume, frags)
+			delete(records, record)
+			continue
+		}
+		if result, ok := cacheVolumeRAMCacheResults[stat]; ok {
+			ch <- prometheus.MustNewConstMetric(cacheVolumeRAMCacheLookups, prometheus.CounterValue, value, volume, result)
+			delete(records, record)
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(cacheVolumeDescs.get(stat), recordType(record), baseValue(record, value), volume)
+		delete(records, record)
+	}
+}
+
+// cacheVolumeNames are the cache volume stats named like their curated
+// total, e.g. trafficserver_cache_used_ratio.
+var cacheVolumeNames = map[string]string{
+	"percent_full":          "used",
+	"bytes_used":            "used_bytes",
+	"bytes_total":           "size_bytes",
+	"direntries.total":      "directory_entries",
+	"direntries.used":       "directory_entries_used",
+	"ram_cache.bytes_used":  "ram_cache_used_bytes",
+	"ram_cache.total_bytes": "ram_cache_size_bytes",
+}
+
+// cacheVolumeRAMCacheResults are the RAM cache lookup stats, which go into
+// one metric by result like trafficserver_ram_cache_lookups_total.
+var cacheVolumeRAMCacheResults = map[string]string{
+	"ram_cache.hits":   "hit",
+	"ram_cache.misses": "miss",
+}
+
+// cacheVolumeMetricName turns a cache volume stat like sync.time into
+// trafficserver_cache_volume_sync_time_seconds_total, with its base unit and
+// _total added.
+func cacheVolumeMetricName(stat string) string {
+	name, ok := cacheVolumeNames[stat]
+	if !ok {
+		name = strings.ToLower(invalidChars.ReplaceAllLiteralString(stat, "_"))
+	}
+	return unitName("trafficserver_cache_volume_"+name, "proxy.process.cache."+stat)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestCacheResults(t *testing.T) {
+	families := scrapeFixture(t, "dynamic")
+
+	mf, ok := families["trafficserver_cache_results_total"]
+	if !ok {
+		t.Fatal("cache results not exported")
+	}
+
+	results := make(map[string]float64)
+	kinds := make(map[string]float64)
+	for _, m := range mf.GetMetric() {
+		var result, kind string
+		for _, lp := range m.GetLabel() {
+			switch lp.GetName() {
+			case "result":
+				result = lp.GetValue()
+			case "kind":
+				kind = lp.GetValue()
+			}
+		}
+		results[result] += m.GetCounter().GetValue()
+		kinds[result+"/"+kind] = m.GetCounter().GetValue()
+	}
+
+	if len(kinds) != 11 {
+		t.Errorf("got %d result kinds, want 11", len(kinds))
+	}
+	for kind, want := range map[string]float64{
+		"hit/fresh":                 32213,
+		"hit/mem_fresh":             134526,
+		"miss/cold":                 5748555,
+		"miss/client_not_cacheable": 2322736,
+		"error/read":                0,
+	} {
+		if got, ok := kinds[kind]; !ok || got != want {
+			t.Errorf("%s: got %g, want %g", kind, got, want)
+		}
+	}
+
+	// The hits add up to proxy.node.cache_total_hits.
+	if got, want := results["hit"], float64(166739); got != want {
+		t.Errorf("got %g hits, want %g", got, want)
+	}
+}
+
+func TestCacheVolumes(t *testing.T) {
+	records := map[string]float64{
+		"proxy.process.cache.volume_1.bytes_used":        10,
+		"proxy.process.cache.volume_12.bytes_used":       20,
+		"proxy.process.cache.volume_12.frags_per_doc.3+": 3,
+		"proxy.process.cache.volume_12.ram_cache.hits":   4,
+		"proxy.process.cache.bytes_used":                 30,
+	}
+	ch := make(chan prometheus.Metric, len(records))
+	collectCacheVolumes(records, ch)
+	close(ch)
+
+	got := make(map[string]string)
+	for m := range ch {
+		var pb dto.Metric
+		if err := m.Write(&pb); err != nil {
+			t.Fatal(err)
+		}
+		labels := ""
+		for _, lp := range pb.GetLabel() {
+			labels += lp.GetName() + "=" + lp.GetValue() + ","
+		}
+		got[labels] = m.Desc().String()
+	}
+
+	if len(got) != 4 {
+		t.Errorf("got %d metrics, want 4: %v", len(got), got)
+	}
+	for labels, name := range map[string]string{
+		"volume=1,":             "trafficserver_cache_volume_used_bytes",
+		"volume=12,":            "trafficserver_cache_volume_used_bytes",
+		"frags=3+,volume=12,":   "trafficserver_cache_volume_frags_per_doc_total",
+		"result=hit,volume=12,": "trafficserver_cache_volume_ram_cache_lookups_total",
+	} {
+		if !strings.Contains(got[labels], `"`+name+`"`) {
+			t.Errorf("metric with %s is %q, want %s", labels, got[labels], name)
+		}
+	}
+	if len(records) != 1 {
+		t.Errorf("volume records were left over: %v", records)
+	}
+}
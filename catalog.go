@@ -0,0 +1,249 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// gaugeRecords is the catalog of ATS records that go up and down. It started
+// out as structs/gauges.go plus the gauges that were filed under
+// structs/counters.go, like the restart timestamps and cache sizes. Anything
+// not listed here is typed by gaugePatterns, and is a counter otherwise.
+var gaugeRecords = map[string]bool{
+	"proxy.process.cache.read_per_sec":                                     true,
+	"proxy.process.cache.write_per_sec":                                    true,
+	"proxy.process.cache.KB_read_per_sec":                                  true,
+	"proxy.process.cache.KB_write_per_sec":                                 true,
+	"proxy.process.http.avg_transactions_per_client_connection":            true,
+	"proxy.process.http.avg_transactions_per_server_connection":            true,
+	"proxy.process.dns.lookup_avg_time":                                    true,
+	"proxy.process.dns.fail_avg_time":                                      true,
+	"proxy.node.http.cache_current_connections_count":                      true,
+	"proxy.node.http.user_agent_current_connections_count":                 true,
+	"proxy.node.http.origin_server_current_connections_count":              true,
+	"proxy.node.proxy_running":                                             true,
+	"proxy.node.config.reconfigure_required":                               true,
+	"proxy.node.config.restart_required.proxy":                             true,
+	"proxy.node.config.restart_required.manager":                           true,
+	"proxy.node.config.restart_required.cop":                               true,
+	"proxy.node.http.user_agent_xacts_per_second":                          true,
+	"proxy.node.user_agent_xacts_per_second":                               true,
+	"proxy.node.dns.lookups_per_second":                                    true,
+	"proxy.node.hostdb.total_lookups_avg_10s":                              true,
+	"proxy.node.hostdb.total_hits_avg_10s":                                 true,
+	"proxy.node.hostdb.hit_ratio_avg_10s":                                  true,
+	"proxy.node.hostdb.hit_ratio":                                          true,
+	"proxy.node.log.bytes_sent_to_network_avg_10s":                         true,
+	"proxy.node.log.bytes_received_from_network_avg_10s":                   true,
+	"proxy.node.user_agent_total_bytes_avg_10s":                            true,
+	"proxy.node.origin_server_total_bytes_avg_10s":                         true,
+	"proxy.node.bandwidth_hit_ratio_avg_10s":                               true,
+	"proxy.node.http.cache_hit_fresh_avg_10s":                              true,
+	"proxy.node.http.cache_hit_mem_fresh_avg_10s":                          true,
+	"proxy.node.http.cache_hit_revalidated_avg_10s":                        true,
+	"proxy.node.http.cache_hit_ims_avg_10s":                                true,
+	"proxy.node.http.cache_hit_stale_served_avg_10s":                       true,
+	"proxy.node.http.cache_miss_cold_avg_10s":                              true,
+	"proxy.node.http.cache_miss_changed_avg_10s":                           true,
+	"proxy.node.http.cache_miss_client_no_cache_avg_10s":                   true,
+	"proxy.node.http.cache_miss_ims_avg_10s":                               true,
+	"proxy.node.http.cache_read_error_avg_10s":                             true,
+	"proxy.node.cache_total_hits_avg_10s":                                  true,
+	"proxy.node.cache_total_hits_mem_avg_10s":                              true,
+	"proxy.node.cache_total_misses_avg_10s":                                true,
+	"proxy.node.cache_hit_ratio_avg_10s":                                   true,
+	"proxy.node.cache_hit_mem_ratio_avg_10s":                               true,
+	"proxy.node.http.transaction_counts_avg_10s.hit_fresh":                 true,
+	"proxy.node.http.transaction_counts_avg_10s.hit_revalidated":           true,
+	"proxy.node.http.transaction_counts_avg_10s.miss_cold":                 true,
+	"proxy.node.http.transaction_counts_avg_10s.miss_changed":              true,
+	"proxy.node.http.transaction_counts_avg_10s.miss_client_no_cache":      true,
+	"proxy.node.http.transaction_counts_avg_10s.miss_not_cacheable":        true,
+	"proxy.node.http.transaction_counts_avg_10s.errors.connect_failed":     true,
+	"proxy.node.http.transaction_counts_avg_10s.errors.aborts":             true,
+	"proxy.node.http.transaction_counts_avg_10s.errors.possible_aborts":    true,
+	"proxy.node.http.transaction_counts_avg_10s.errors.pre_accept_hangups": true,
+	"proxy.node.http.transaction_counts_avg_10s.errors.other":              true,
+	"proxy.node.http.transaction_frac_avg_10s.hit_fresh":                   true,
+	"proxy.node.http.transaction_frac_avg_10s.hit_revalidated":             true,
+	"proxy.node.http.transaction_frac_avg_10s.miss_cold":                   true,
+	"proxy.node.http.transaction_frac_avg_10s.miss_changed":                true,
+	"proxy.node.http.transaction_frac_avg_10s.miss_client_no_cache":        true,
+	"proxy.node.http.transaction_frac_avg_10s.miss_not_cacheable":          true,
+	"proxy.node.http.current_parent_proxy_connections":                     true,
+	"proxy.node.http.transaction_frac_avg_10s.errors.connect_failed":       true,
+	"proxy.node.http.transaction_frac_avg_10s.errors.aborts":               true,
+	"proxy.node.http.transaction_frac_avg_10s.errors.possible_aborts":      true,
+	"proxy.node.http.transaction_frac_avg_10s.errors.pre_accept_hangups":   true,
+	"proxy.node.http.transaction_frac_avg_10s.errors.other":                true,
+	"proxy.node.http.transaction_msec_avg_10s.hit_fresh":                   true,
+	"proxy.node.http.transaction_msec_avg_10s.hit_revalidated":             true,
+	"proxy.node.http.transaction_msec_avg_10s.miss_cold":                   true,
+	"proxy.node.http.transaction_msec_avg_10s.miss_changed":                true,
+	"proxy.node.http.transaction_msec_avg_10s.miss_client_no_cache":        true,
+	"proxy.node.http.transaction_msec_avg_10s.miss_not_cacheable":          true,
+	"proxy.node.http.transaction_msec_avg_10s.errors.connect_failed":       true,
+	"proxy.node.http.transaction_msec_avg_10s.errors.aborts":               true,
+	"proxy.node.http.transaction_msec_avg_10s.errors.possible_aborts":      true,
+	"proxy.node.http.transaction_msec_avg_10s.errors.pre_accept_hangups":   true,
+	"proxy.node.http.transaction_msec_avg_10s.errors.other":                true,
+	"proxy.node.current_client_connections":                                true,
+	"proxy.node.current_server_connections":                                true,
+	"proxy.node.current_cache_connections":                                 true,
+	"proxy.process.socks.connections_currently_open":                       true,
+	"proxy.process.http.background_fill_current_count":                     true,
+	"proxy.process.http.current_client_connections":                        true,
+	"proxy.process.http.current_active_client_connections":                 true,
+	"proxy.process.http.websocket.current_active_client_connections":       true,
+	"proxy.process.http.current_client_transactions":                       true,
+	"proxy.process.http.current_server_transactions":                       true,
+	"proxy.process.http.current_parent_proxy_connections":                  true,
+	"proxy.process.http.current_server_connections":                        true,
+	"proxy.process.http.current_cache_connections":                         true,
+	"proxy.process.net.accepts_currently_open":                             true,
+	"proxy.process.net.connections_currently_open":                         true,
+	"proxy.process.http2.current_client_sessions":                          true,
+	"proxy.process.http2.current_client_streams":                           true,
+	"proxy.process.hostdb.cache.current_items":                             true,
+	"proxy.process.hostdb.cache.current_size":                              true,
+	"proxy.process.dns.success_avg_time":                                   true,
+	"proxy.process.log.log_files_open":                                     true,
+	"proxy.process.log.log_files_space_used":                               true,
+
+	"proxy.node.restarts.manager.start_time":           true,
+	"proxy.node.restarts.proxy.start_time":             true,
+	"proxy.node.restarts.proxy.cache_ready_time":       true,
+	"proxy.node.restarts.proxy.stop_time":              true,
+	"proxy.node.config.reconfigure_time":               true,
+	"proxy.node.bandwidth_hit_ratio":                   true,
+	"proxy.node.cache_hit_ratio":                       true,
+	"proxy.node.cache_hit_mem_ratio":                   true,
+	"proxy.node.cache.bytes_total":                     true,
+	"proxy.node.cache.bytes_total_mb":                  true,
+	"proxy.node.cache.bytes_free":                      true,
+	"proxy.node.cache.bytes_free_mb":                   true,
+	"proxy.node.cache.percent_free":                    true,
+	"proxy.process.cache.bytes_used":                   true,
+	"proxy.process.cache.bytes_total":                  true,
+	"proxy.process.cache.ram_cache.total_bytes":        true,
+	"proxy.process.cache.ram_cache.bytes_used":         true,
+	"proxy.process.cache.percent_full":                 true,
+	"proxy.process.cache.direntries.total":             true,
+	"proxy.process.cache.direntries.used":              true,
+	"proxy.process.cache.lookup.active":                true,
+	"proxy.process.cache.read.active":                  true,
+	"proxy.process.cache.write.active":                 true,
+	"proxy.process.cache.update.active":                true,
+	"proxy.process.cache.remove.active":                true,
+	"proxy.process.cache.evacuate.active":              true,
+	"proxy.process.cache.scan.active":                  true,
+	"proxy.process.cache.span.failing":                 true,
+	"proxy.process.cache.span.offline":                 true,
+	"proxy.process.cache.span.online":                  true,
+	"proxy.process.dns.in_flight":                      true,
+	"proxy.process.hostdb.cache.last_sync.time":        true,
+	"proxy.process.hostdb.cache.last_sync.total_items": true,
+	"proxy.process.hostdb.cache.last_sync.total_size":  true,
+}
+
+// gaugePatterns catch gauges among records that aren't in the catalog yet,
+// e.g. from newer ATS versions or plugins.
+var gaugePatterns = regexp.MustCompile(`current_|currently_open|_ratio|avg_|percent_|_per_sec$|_per_second$|\.active$|in_flight`)
+
+// volumeRecord matches the per cache volume copies of the proxy.process.cache
+// records, which are typed like the totals.
+var volumeRecord = regexp.MustCompile(`^proxy\.process\.cache\.volume_[0-9]+\.`)
+
+// recordType decides whether an ATS record is exported as a counter or a
+// gauge.
+func recordType(record string) prometheus.ValueType {
+	record = volumeRecord.ReplaceAllLiteralString(record, "proxy.process.cache.")
+	if gaugeRecords[record] || gaugePatterns.MatchString(record) {
+		return prometheus.GaugeValue
+	}
+	return prometheus.CounterValue
+}
+
+// unit is the base unit a record is converted to, the factor to get there
+// from the unit ATS reports it in, and the word ATS uses for that unit in
+// record names, if any.
+type unit struct {
+	name  string
+	scale float64
+	ats   string
+}
+
+var (
+	nanoseconds  = unit{"seconds", 1e-9, ""}
+	milliseconds = unit{"seconds", 1e-3, "msec"}
+	byteCount    = unit{"bytes", 1, "bytes"}
+	percent      = unit{"ratio", 0.01, "percent"}
+)
+
+// recordUnits are the records whose unit can't be told from their name. The
+// transaction_totaltime records are left out, ATS already reports them in
+// seconds.
+var recordUnits = map[string]unit{
+	"proxy.process.http.total_transactions_time":       nanoseconds,
+	"proxy.process.http.parent_proxy_transaction_time": nanoseconds,
+	"proxy.process.ssl.total_handshake_time":           nanoseconds,
+	"proxy.process.cache.sync.time":                    nanoseconds,
+	"proxy.process.cache.percent_full":                 percent,
+}
+
+var (
+	// Byte counts, but not the transfer speed buckets, the _mb copies or
+	// the _avg_10s rates.
+	bytesRecord    = regexp.MustCompile(`bytes|_total_size$`)
+	notBytesRecord = regexp.MustCompile(`bytes_per_sec|_mb$|avg_`)
+
+	// The transaction times traffic_manager averages and the times of the
+	// cluster records are in milliseconds.
+	millisecondsRecord = regexp.MustCompile(`^proxy\.node\.http\.transaction_msec_avg_10s\.|^proxy\.process\.cluster\..+_time$`)
+)
+
+// recordUnit returns the base unit of an ATS record, if it has one.
+func recordUnit(record string) (unit, bool) {
+	record = volumeRecord.ReplaceAllLiteralString(record, "proxy.process.cache.")
+	if u, ok := recordUnits[record]; ok {
+		return u, true
+	}
+	if millisecondsRecord.MatchString(record) {
+		return milliseconds, true
+	}
+	if bytesRecord.MatchString(record) && !notBytesRecord.MatchString(record) {
+		return byteCount, true
+	}
+	return unit{}, false
+}
+
+// unitName moves the base unit of a record to the end of its metric name,
+// dropping the unit ATS names it by, and adds _total if it's a counter, e.g.
+// trafficserver_proxy_process_log_bytes_written_to_disk becomes
+// trafficserver_proxy_process_log_written_to_disk_bytes_total.
+func unitName(name, record string) string {
+	if u, ok := recordUnit(record); ok {
+		words := strings.Split(name, "_")
+		kept := words[:0]
+		for _, word := range words {
+			if word != u.name && word != u.ats {
+				kept = append(kept, word)
+			}
+		}
+		name = strings.Join(kept, "_") + "_" + u.name
+	}
+	if recordType(record) == prometheus.CounterValue && !strings.HasSuffix(name, "_total") {
+		name += "_total"
+	}
+	return name
+}
+
+// baseValue converts the value of a record to its base unit.
+func baseValue(record string, value float64) float64 {
+	if u, ok := recordUnit(record); ok {
+		return value * u.scale
+	}
+	return value
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// scrapeFixture runs the collector against test/trafficserver.json and
+// returns the gathered metric families by name.
+func scrapeFixture(t *testing.T, version string) map[string]*dto.MetricFamily {
+	module := defaultModule
+	module.Version = version
+	return scrapeModule(t, module)
+}
+
+// scrapeModule is scrapeFixture with a module of its own.
+func scrapeModule(t *testing.T, module Module) map[string]*dto.MetricFamily {
+	ts := httptest.NewServer(http.FileServer(http.Dir("test")))
+	defer ts.Close()
+
+	c, err := NewTrafficServerCollector(ts.URL+"/trafficserver.json", module)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(c)
+	mfs, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	families := make(map[string]*dto.MetricFamily, len(mfs))
+	for _, mf := range mfs {
+		families[mf.GetName()] = mf
+	}
+	return families
+}
+
+func TestRecordType(t *testing.T) {
+	for record, want := range map[string]prometheus.ValueType{
+		// From the catalog.
+		"proxy.process.http.current_client_connections": prometheus.GaugeValue,
+		"proxy.node.cache_hit_ratio":                    prometheus.GaugeValue,
+		"proxy.node.restarts.proxy.start_time":          prometheus.GaugeValue,
+		"proxy.process.cache.bytes_used":                prometheus.GaugeValue,
+		"proxy.process.cache.volume_3.bytes_used":       prometheus.GaugeValue,
+		"proxy.process.http.completed_requests":         prometheus.CounterValue,
+		"proxy.node.restarts.proxy.restart_count":       prometheus.CounterValue,
+		// From the patterns.
+		"proxy.process.plugin.current_sessions":                prometheus.GaugeValue,
+		"proxy.node.foo_avg_10s":                               prometheus.GaugeValue,
+		"proxy.process.plugin.hit_ratio":                       prometheus.GaugeValue,
+		"proxy.process.cache.volume_1.percent_full":            prometheus.GaugeValue,
+		"proxy.process.plugin.lookups":                         prometheus.CounterValue,
+		"proxy.process.http.user_agent_speed_bytes_per_sec_1K": prometheus.CounterValue,
+	} {
+		if got := recordType(record); got != want {
+			t.Errorf("recordType(%q) = %v, want %v", record, got, want)
+		}
+	}
+}
+
+func TestCollectTypes(t *testing.T) {
+	families := scrapeFixture(t, "dynamic")
+
+	// The families that replace the records they collapse, with their
+	// type.
+	for name, want := range map[string]dto.MetricType{
+		"trafficserver_up":                                     dto.MetricType_GAUGE,
+		"trafficserver_http_connections":                       dto.MetricType_GAUGE,
+		"trafficserver_node_cache_hit_ratio":                   dto.MetricType_GAUGE,
+		"trafficserver_proxy_node_cache_hit_ratio_avg_10s":     dto.MetricType_GAUGE,
+		"trafficserver_http_completed_requests_total":          dto.MetricType_COUNTER,
+		"trafficserver_http_user_agent_speed_bytes_per_second": dto.MetricType_HISTOGRAM,
+		"trafficserver_http_request_document_size_bytes":       dto.MetricType_HISTOGRAM,
+		"trafficserver_http_responses_total":                   dto.MetricType_COUNTER,
+		"trafficserver_http_responses_by_class_total":          dto.MetricType_COUNTER,
+		"trafficserver_http_requests_total":                    dto.MetricType_COUNTER,
+		"trafficserver_cache_results_total":                    dto.MetricType_COUNTER,
+		"trafficserver_transactions":                           dto.MetricType_SUMMARY,
+		"trafficserver_cache_volume_used_ratio":                dto.MetricType_GAUGE,
+		"trafficserver_cache_volume_read_success_total":        dto.MetricType_COUNTER,
+		"trafficserver_cache_volume_size_bytes":                dto.MetricType_GAUGE,
+		"trafficserver_cache_volume_directory_entries":         dto.MetricType_GAUGE,
+		"trafficserver_cache_volume_ram_cache_lookups_total":   dto.MetricType_COUNTER,
+		"trafficserver_ssl_cipher_handshakes_total":            dto.MetricType_COUNTER,
+		"trafficserver_ssl_errors_total":                       dto.MetricType_COUNTER,
+		"trafficserver_ssl_session_cache_lookups_total":        dto.MetricType_COUNTER,
+		"trafficserver_http2_client_sessions":                  dto.MetricType_GAUGE,
+		"trafficserver_http2_session_deaths_total":             dto.MetricType_COUNTER,
+		"trafficserver_http2_transactions_time_seconds_total":  dto.MetricType_COUNTER,
+		"trafficserver_proxy_start_time_seconds":               dto.MetricType_GAUGE,
+		"trafficserver_proxy_restarts_total":                   dto.MetricType_COUNTER,
+		"trafficserver_cache_warmup_seconds":                   dto.MetricType_GAUGE,
+		"trafficserver_build_info":                             dto.MetricType_GAUGE,
+		"trafficserver_exporter_scrape_duration_seconds":       dto.MetricType_GAUGE,
+		"trafficserver_http_milestone_seconds_total":           dto.MetricType_COUNTER,
+		"trafficserver_http_phase_seconds_total":               dto.MetricType_COUNTER,
+		"trafficserver_hostdb_lookups_total":                   dto.MetricType_COUNTER,
+		"trafficserver_dns_lookup_results_total":               dto.MetricType_COUNTER,
+		"trafficserver_hostdb_hit_ratio":                       dto.MetricType_GAUGE,
+	} {
+		mf, ok := families[name]
+		if !ok {
+			t.Errorf("metric %s not exported", name)
+			continue
+		}
+		if got := mf.GetType(); got != want {
+			t.Errorf("%s has type %v, want %v", name, got, want)
+		}
+	}
+
+	// The records that the families above consume must not show up on
+	// their own as well.
+	for _, re := range []string{
+		`trafficserver_proxy_process_http_(user_agent|origin_server)_speed_bytes_per_sec_.*`,
+		`trafficserver_proxy_process_http_(request|response)_document_size_.*`,
+		`trafficserver_proxy_process_http_[0-9]+_responses`,
+		`trafficserver_proxy_process_http_[0-9]xx_responses`,
+		`trafficserver_proxy_process_http_(get|post|head|put|delete|purge|options|trace|push|connect|extension_method)_requests`,
+		`trafficserver_proxy_process_http_cache_(hit|miss)_.*`,
+		`trafficserver_proxy_process_http_transaction_(counts|totaltime)_.*`,
+		`trafficserver_proxy_process_cache_volume_[0-9]+_.*`,
+		`trafficserver_proxy_process_ssl_cipher_.*`,
+		`trafficserver_proxy_process_ssl_(user_agent|origin_server)_.*`,
+		`trafficserver_proxy_process_ssl_ssl_session_cache_.*`,
+		`trafficserver_proxy_process_http2_.*`,
+		`trafficserver_proxy_node_restarts_.*`,
+		`trafficserver_proxy_process_http_milestone_.*`,
+		`trafficserver_proxy_process_(hostdb|dns)_(total_.*|cache_.*|lookup_.*|retries|in_flight)`,
+	} {
+		anchored := regexp.MustCompile("^(?:" + re + ")$")
+		for name := range families {
+			if anchored.MatchString(name) {
+				t.Errorf("record metric %s is exported next to its family", name)
+			}
+		}
+	}
+}
+
+func TestUnits(t *testing.T) {
+	families := scrapeFixture(t, "dynamic")
+
+	for name, want := range map[string]float64{
+		// Nanoseconds.
+		"trafficserver_http_transactions_time_seconds_total":        2177141.880861186,
+		"trafficserver_proxy_process_cache_sync_time_seconds_total": 7476.28877407,
+		"trafficserver_cache_volume_sync_time_seconds_total":        7476.288776781,
+		// Bytes, with the unit moved to the end of the name.
+		"trafficserver_proxy_process_log_written_to_disk_bytes_total": 3284330966,
+		"trafficserver_cache_volume_ram_cache_used_bytes":             8014259712,
+		// Milliseconds, zero in the fixture.
+		"trafficserver_proxy_node_http_transaction_avg_10s_hit_fresh_seconds": 0,
+		"trafficserver_proxy_process_cluster_cluster_ping_time_seconds_total": 0,
+		// Percent.
+		"trafficserver_node_cache_free_ratio":   0.0000016,
+		"trafficserver_cache_used_ratio":        0.99,
+		"trafficserver_cache_volume_used_ratio": 0.99,
+	} {
+		mf, ok := families[name]
+		if !ok {
+			t.Errorf("metric %s not exported", name)
+			continue
+		}
+		m := mf.GetMetric()[0]
+		if got := m.GetCounter().GetValue() + m.GetGauge().GetValue(); math.Abs(got-want) > 1e-6 {
+			t.Errorf("%s = %f, want %f", name, got, want)
+		}
+	}
+
+	for record, want := range map[string]float64{
+		"proxy.node.http.transaction_msec_avg_10s.miss_cold": 1.5,
+		"proxy.process.cluster.cluster_ping_time":            1.5,
+		"proxy.process.cluster.connections_avg_time":         1.5,
+	} {
+		if got := baseValue(record, 1500); got != want {
+			t.Errorf("%s = %f, want %f", record, got, want)
+		}
+	}
+
+	for _, name := range []string{
+		// Rates and speed buckets aren't byte counts.
+		"trafficserver_proxy_node_user_agent_total_bytes_avg_10s",
+		// ATS already reports the transaction times in seconds.
+		"trafficserver_transactions",
+	} {
+		if _, ok := families[name]; !ok {
+			t.Errorf("metric %s not exported", name)
+		}
+	}
+}
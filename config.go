@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sync"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Config is the contents of --config.file.
+type Config struct {
+	Modules map[string]Module `yaml:"modules"`
+}
+
+// Module holds the scrape settings a /probe request can select with the
+// module query parameter. The target only supplies the host.
+type Module struct {
+	Path      string            `yaml:"path"`
+	Timeout   time.Duration     `yaml:"timeout"`
+	TLSConfig TLSConfig         `yaml:"tls_config"`
+	Headers   map[string]string `yaml:"headers"`
+	BasicAuth *BasicAuth        `yaml:"basic_auth"`
+	Metrics   MetricsFilter     `yaml:"metrics"`
+	Version   string            `yaml:"version"`
+	Naming    string            `yaml:"naming"`
+}
+
+// TLSConfig configures how the exporter talks to https scrape targets.
+type TLSConfig struct {
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+	CAFile             string `yaml:"ca_file"`
+	CertFile           string `yaml:"cert_file"`
+	KeyFile            string `yaml:"key_file"`
+	ServerName         string `yaml:"server_name"`
+}
+
+// BasicAuth is sent with every scrape when set.
+type BasicAuth struct {
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+}
+
+// MetricsFilter selects which ATS records are exported. Both lists hold
+// regular expressions matched against the full record name, e.g.
+// "proxy\.process\.http\..*". An empty include list includes everything and
+// exclude wins over include.
+type MetricsFilter struct {
+	Include []string `yaml:"include"`
+	Exclude []string `yaml:"exclude"`
+
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+var defaultModule = Module{
+	Path:    "/_stats",
+	Timeout: 5 * time.Second,
+	Version: "7.1",
+	Naming:  namingCurated,
+}
+
+func (m *Module) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*m = defaultModule
+	type plain Module
+	if err := unmarshal((*plain)(m)); err != nil {
+		return err
+	}
+	return m.validate()
+}
+
+func (m *Module) validate() error {
+	if m.Timeout <= 0 {
+		return fmt.Errorf("timeout must be positive, got %s", m.Timeout)
+	}
+	if _, ok := profiles[m.Version]; !ok {
+		return fmt.Errorf("unknown version profile %q", m.Version)
+	}
+	if m.Naming != namingLegacy && m.Naming != namingCurated {
+		return fmt.Errorf("unknown naming scheme %q, must be %s or %s", m.Naming, namingLegacy, namingCurated)
+	}
+	if (m.TLSConfig.CertFile == "") != (m.TLSConfig.KeyFile == "") {
+		return fmt.Errorf("tls_config needs both cert_file and key_file")
+	}
+	return m.Metrics.compile()
+}
+
+func (f *MetricsFilter) compile() error {
+	var err error
+	if f.include, err = compileAnchored(f.Include); err != nil {
+		return err
+	}
+	f.exclude, err = compileAnchored(f.Exclude)
+	return err
+}
+
+func compileAnchored(exprs []string) ([]*regexp.Regexp, error) {
+	res := make([]*regexp.Regexp, 0, len(exprs))
+	for _, expr := range exprs {
+		re, err := regexp.Compile("^(?:" + expr + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid metrics filter %q: %s", expr, err)
+		}
+		res = append(res, re)
+	}
+	return res, nil
+}
+
+// Match reports whether the ATS record should be exported.
+func (f MetricsFilter) Match(record string) bool {
+	for _, re := range f.exclude {
+		if re.MatchString(record) {
+			return false
+		}
+	}
+	if len(f.include) == 0 {
+		return true
+	}
+	for _, re := range f.include {
+		if re.MatchString(record) {
+			return true
+		}
+	}
+	return false
+}
+
+// SafeConfig guards the modules so that they can be swapped out by a reload
+// while probes are running.
+type SafeConfig struct {
+	sync.RWMutex
+	C *Config
+
+	// defaults are the modules built from the command-line flags. Modules
+	// from the config file with the same name replace them.
+	defaults map[string]Module
+}
+
+func NewSafeConfig(defaults map[string]Module) *SafeConfig {
+	return &SafeConfig{
+		C:        &Config{Modules: defaults},
+		defaults: defaults,
+	}
+}
+
+// ReloadConfig loads the config file and only swaps it in when it is valid,
+// so a broken edit keeps the previous modules running.
+func (sc *SafeConfig) ReloadConfig(file string) error {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("error reading config file: %s", err)
+	}
+
+	c := &Config{}
+	if err := yaml.UnmarshalStrict(data, c); err != nil {
+		return fmt.Errorf("error parsing config file: %s", err)
+	}
+
+	modules := make(map[string]Module, len(sc.defaults)+len(c.Modules))
+	for name, module := range sc.defaults {
+		modules[name] = module
+	}
+	for name, module := range c.Modules {
+		modules[name] = module
+	}
+	c.Modules = modules
+
+	sc.Lock()
+	sc.C = c
+	sc.Unlock()
+	return nil
+}
+
+func (sc *SafeConfig) Module(name string) (Module, bool) {
+	sc.RLock()
+	defer sc.RUnlock()
+	module, ok := sc.C.Modules[name]
+	return module, ok
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+func TestModuleUnmarshal(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config string
+		want   Module
+		err    string
+	}{
+		{
+			name:   "empty",
+			config: `{}`,
+			want:   defaultModule,
+		},
+		{
+			name:   "partial",
+			config: "path: /stats\ntimeout: 2s\n",
+			want:   Module{Path: "/stats", Timeout: 2 * time.Second, Version: "7.1", Naming: namingCurated},
+		},
+		{
+			name:   "partial naming",
+			config: "version: dynamic\nnaming: legacy\n",
+			want:   Module{Path: "/_stats", Timeout: 5 * time.Second, Version: "dynamic", Naming: namingLegacy},
+		},
+		{
+			name:   "unknown key",
+			config: "path: /stats\ntimeuot: 2s\n",
+			err:    "field timeuot not found",
+		},
+		{
+			name:   "unknown version",
+			config: "version: '6.2'\n",
+			err:    `unknown version profile "6.2"`,
+		},
+		{
+			name:   "unknown naming",
+			config: "naming: pretty\n",
+			err:    `unknown naming scheme "pretty"`,
+		},
+		{
+			name:   "zero timeout",
+			config: "timeout: 0s\n",
+			err:    "timeout must be positive",
+		},
+		{
+			name:   "cert without key",
+			config: "tls_config:\n  cert_file: client.crt\n",
+			err:    "needs both cert_file and key_file",
+		},
+		{
+			name:   "invalid filter",
+			config: "metrics:\n  include: ['proxy.(']\n",
+			err:    "invalid metrics filter",
+		},
+	} {
+		var m Module
+		err := yaml.UnmarshalStrict([]byte(tc.config), &m)
+		if tc.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("%s: got error %v, want %q", tc.name, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+			continue
+		}
+		m.Metrics = MetricsFilter{}
+		if m.Path != tc.want.Path || m.Timeout != tc.want.Timeout || m.Version != tc.want.Version || m.Naming != tc.want.Naming {
+			t.Errorf("%s: got %+v, want %+v", tc.name, m, tc.want)
+		}
+	}
+}
+
+func TestMetricsFilter(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		include []string
+		exclude []string
+		matches map[string]bool
+	}{
+		{
+			name: "empty",
+			matches: map[string]bool{
+				"proxy.process.http.completed_requests": true,
+				"proxy.node.restarts.proxy.start_time":  true,
+			},
+		},
+		{
+			name:    "anchored include",
+			include: []string{`proxy\.process\.http\..*`},
+			matches: map[string]bool{
+				"proxy.process.http.completed_requests":       true,
+				"proxy.process.http2.current_client_sessions": false,
+				"x.proxy.process.http.completed_requests":     false,
+			},
+		},
+		{
+			name:    "no partial match",
+			include: []string{`proxy\.process\.http`},
+			matches: map[string]bool{
+				"proxy.process.http":                    true,
+				"proxy.process.http.completed_requests": false,
+			},
+		},
+		{
+			name:    "alternation stays anchored",
+			include: []string{`proxy\.process\.ssl\..*|proxy\.process\.dns\..*`},
+			matches: map[string]bool{
+				"proxy.process.ssl.total_tickets_renewed": true,
+				"proxy.process.dns.retries":               true,
+				"proxy.process.http.dns.retries":          false,
+			},
+		},
+		{
+			name:    "exclude wins",
+			include: []string{`proxy\.process\.http\..*`},
+			exclude: []string{`proxy\.process\.http\.milestone\..*`},
+			matches: map[string]bool{
+				"proxy.process.http.completed_requests": true,
+				"proxy.process.http.milestone.ua_begin": false,
+			},
+		},
+		{
+			name:    "exclude only",
+			exclude: []string{`proxy\.process\.cache\.volume_[0-9]+\..*`},
+			matches: map[string]bool{
+				"proxy.process.cache.bytes_used":          true,
+				"proxy.process.cache.volume_1.bytes_used": false,
+			},
+		},
+	} {
+		f := MetricsFilter{Include: tc.include, Exclude: tc.exclude}
+		if err := f.compile(); err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+			continue
+		}
+		for record, want := range tc.matches {
+			if got := f.Match(record); got != want {
+				t.Errorf("%s: Match(%q) = %t, want %t", tc.name, record, got, want)
+			}
+		}
+	}
+}
+
+func TestReloadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trafficserver_exporter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "config.yml")
+
+	flags := defaultModule
+	flags.Path = "/flags"
+	sc := NewSafeConfig(map[string]Module{"default": flags})
+
+	for _, tc := range []struct {
+		name    string
+		config  string
+		err     bool
+		modules map[string]string
+	}{
+		{
+			name:    "file overrides default",
+			config:  "modules:\n  default:\n    path: /file\n  secure:\n    path: /secure\n",
+			modules: map[string]string{"default": "/file", "secure": "/secure"},
+		},
+		{
+			name:    "invalid module keeps previous",
+			config:  "modules:\n  default:\n    path: /broken\n    timeout: -1s\n",
+			err:     true,
+			modules: map[string]string{"default": "/file", "secure": "/secure"},
+		},
+		{
+			name:    "unknown key keeps previous",
+			config:  "modules:\n  default:\n    pth: /broken\n",
+			err:     true,
+			modules: map[string]string{"default": "/file", "secure": "/secure"},
+		},
+		{
+			name:    "invalid yaml keeps previous",
+			config:  "modules: [",
+			err:     true,
+			modules: map[string]string{"default": "/file", "secure": "/secure"},
+		},
+		{
+			name:    "default from flags without file module",
+			config:  "modules:\n  other:\n    path: /other\n",
+			modules: map[string]string{"default": "/flags", "other": "/other", "secure": ""},
+		},
+	} {
+		if err := ioutil.WriteFile(file, []byte(tc.config), 0644); err != nil {
+			t.Fatal(err)
+		}
+		err := sc.ReloadConfig(file)
+		if tc.err != (err != nil) {
+			t.Errorf("%s: got error %v, want error %t", tc.name, err, tc.err)
+		}
+		for name, path := range tc.modules {
+			module, ok := sc.Module(name)
+			if path == "" {
+				if ok {
+					t.Errorf("%s: module %q still present", tc.name, name)
+				}
+				continue
+			}
+			if !ok {
+				t.Errorf("%s: module %q missing", tc.name, name)
+				continue
+			}
+			if module.Path != path {
+				t.Errorf("%s: module %q has path %q, want %q", tc.name, name, module.Path, path)
+			}
+		}
+	}
+
+	if err := sc.ReloadConfig(filepath.Join(dir, "missing.yml")); err == nil {
+		t.Error("expected error reading a missing file")
+	}
+	if module, _ := sc.Module("default"); module.Path != "/flags" {
+		t.Errorf("missing file replaced the modules, default has path %q", module.Path)
+	}
+}
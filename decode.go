@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// stringRecordPrefixes and stringRecords are the records that ATS reports as
+// strings on purpose. They are left alone instead of counting as parse
+// errors, even the ones that look like numbers, like the build numbers.
+var stringRecordPrefixes = []string{
+	"proxy.node.version.",
+	"proxy.process.version.",
+}
+
+var stringRecords = map[string]bool{
+	"proxy.node.hostname":    true,
+	"proxy.node.hostname_FQ": true,
+	"server":                 true,
+}
+
+func isStringRecord(record string) bool {
+	if stringRecords[record] {
+		return true
+	}
+	for _, prefix := range stringRecordPrefixes {
+		if strings.HasPrefix(record, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRecords decodes each record on its own, so that one odd value doesn't
+// fail the whole scrape. Numbers are accepted quoted, as some ATS versions
+// and plugins emit them. The string records are returned separately and
+// every other record that isn't a number is returned as a parse error.
+func parseRecords(global map[string]json.RawMessage) (records map[string]float64, strs map[string]string, errs []string) {
+	records = make(map[string]float64, len(global))
+	strs = make(map[string]string)
+
+	for record, raw := range global {
+		// Unmarshaling null is a no-op rather than an error.
+		if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+			errs = append(errs, record)
+			continue
+		}
+
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			if isStringRecord(record) {
+				strs[record] = s
+				continue
+			}
+			if v, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+				records[record] = v
+				continue
+			}
+			strs[record] = s
+			errs = append(errs, record)
+			continue
+		}
+
+		var v float64
+		if err := json.Unmarshal(raw, &v); err != nil {
+			errs = append(errs, record)
+			continue
+		}
+		records[record] = v
+	}
+	return records, strs, errs
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestParseRecords(t *testing.T) {
+	var global map[string]json.RawMessage
+	err := json.Unmarshal([]byte(`{
+		"proxy.process.http.completed_requests": 42,
+		"proxy.process.http.incoming_requests": "17",
+		"proxy.process.plugin.ratio": " 0.5 ",
+		"proxy.process.version.server.build_number": "120511",
+		"proxy.node.hostname": "ats01",
+		"proxy.process.plugin.state": "warming",
+		"proxy.process.plugin.nothing": null,
+		"proxy.process.plugin.enabled": true,
+		"proxy.process.plugin.list": [1, 2]
+	}`), &global)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records, strs, errs := parseRecords(global)
+
+	wantRecords := map[string]float64{
+		"proxy.process.http.completed_requests": 42,
+		"proxy.process.http.incoming_requests":  17,
+		"proxy.process.plugin.ratio":            0.5,
+	}
+	if !reflect.DeepEqual(records, wantRecords) {
+		t.Errorf("got records %v, want %v", records, wantRecords)
+	}
+	wantStrs := map[string]string{
+		"proxy.process.version.server.build_number": "120511",
+		"proxy.node.hostname":                       "ats01",
+		"proxy.process.plugin.state":                "warming",
+	}
+	if !reflect.DeepEqual(strs, wantStrs) {
+		t.Errorf("got strings %v, want %v", strs, wantStrs)
+	}
+	sort.Strings(errs)
+	wantErrs := []string{
+		"proxy.process.plugin.enabled",
+		"proxy.process.plugin.list",
+		"proxy.process.plugin.nothing",
+		"proxy.process.plugin.state",
+	}
+	if !reflect.DeepEqual(errs, wantErrs) {
+		t.Errorf("got parse errors %v, want %v", errs, wantErrs)
+	}
+}
+
+func TestCollectParseErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"global": {
+			"proxy.process.http.completed_requests": "42",
+			"proxy.process.plugin.state": "warming"
+		}}`))
+	}))
+	defer ts.Close()
+
+	module := defaultModule
+	module.Version = "dynamic"
+	c, err := NewTrafficServerCollector(ts.URL, module)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(c)
+
+	for i := 1; i <= 2; i++ {
+		mfs, err := registry.Gather()
+		if err != nil {
+			t.Fatal(err)
+		}
+		values := make(map[string]float64)
+		for _, mf := range mfs {
+			for _, m := range mf.GetMetric() {
+				values[mf.GetName()] = m.GetGauge().GetValue() + m.GetCounter().GetValue()
+			}
+		}
+		if got := values["trafficserver_up"]; got != 1 {
+			t.Errorf("got up %g, want 1", got)
+		}
+		if got := values["trafficserver_http_completed_requests_total"]; got != 42 {
+			t.Errorf("got %g completed requests, want 42", got)
+		}
+		if got := values["trafficserver_exporter_parse_errors_total"]; got != float64(i) {
+			t.Errorf("scrape %d: got %g parse errors, want %d", i, got, i)
+		}
+	}
+}
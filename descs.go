@@ -0,0 +1,193 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// descCache builds the descriptor of each metric name once and hands out the
+// same *prometheus.Desc on every scrape. Names it doesn't know yet, such as
+// plugin records with the dynamic profile, are added on first use.
+type descCache struct {
+	mtx   sync.RWMutex
+	descs map[string]*prometheus.Desc
+
+	// name maps a key to its metric name, keys with the same name share
+	// a descriptor.
+	name func(key string) string
+	new  func(name, key string) *prometheus.Desc
+}
+
+func newDescCache(name func(string) string, new func(name, key string) *prometheus.Desc, keys []string) *descCache {
+	c := &descCache{
+		descs: make(map[string]*prometheus.Desc, len(keys)),
+		name:  name,
+		new:   new,
+	}
+	// Sorted, so that of two keys with the same name the first one's help
+	// wins, like in collectRecords.
+	sort.Strings(keys)
+	for _, key := range keys {
+		c.get(key)
+	}
+	return c
+}
+
+func (c *descCache) get(key string) *prometheus.Desc {
+	name := c.name(key)
+
+	c.mtx.RLock()
+	desc, ok := c.descs[name]
+	c.mtx.RUnlock()
+	if ok {
+		return desc
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if desc, ok = c.descs[name]; !ok {
+		desc = c.new(name, key)
+		c.descs[name] = desc
+	}
+	return desc
+}
+
+func (c *descCache) describe(ch chan<- *prometheus.Desc) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	for _, desc := range c.descs {
+		ch <- desc
+	}
+}
+
+// recordDescs are the descriptors of the records exported one metric per
+// record, built up front for the records ATS reports, the records of the
+// version profiles and the ones with help text. legacyDescs are the same for
+// the legacy naming.
+var (
+	recordDescs = newDescCache(recordMetricName, newRecordDesc, knownRecords(false))
+	legacyDescs = newDescCache(metricName, newRecordDesc, knownRecords(true))
+)
+
+func newRecordDesc(name, record string) *prometheus.Desc {
+	return prometheus.NewDesc(name, recordHelpText(record), nil, nil)
+}
+
+// cacheVolumeDescs are the descriptors of the per cache volume metrics, by
+// the record name without the volume.
+var cacheVolumeDescs = newDescCache(cacheVolumeMetricName, func(name, stat string) *prometheus.Desc {
+	help, ok := cacheVolumeHelp[stat]
+	if !ok {
+		help = "Trafficserver cache volume metric " + stat
+	}
+	return prometheus.NewDesc(name, help, []string{"volume"}, nil)
+}, cacheVolumeStats())
+
+// knownRecords returns the records ATS reports, the records of all version
+// profiles and the ones with help text. For the curated naming it leaves out
+// the curated records and the cache volume records, which have descriptors
+// of their own. Duplicates are fine, descCache keeps one descriptor per name.
+func knownRecords(legacy bool) []string {
+	var records []string
+	add := func(record string) {
+		if legacy || !curatedRecords[record] && !volumeRecord.MatchString(record) {
+			records = append(records, record)
+		}
+	}
+	for _, record := range atsRecords {
+		add(record)
+	}
+	for _, profile := range profiles {
+		for record := range profile {
+			add(record)
+		}
+	}
+	for record := range recordHelp {
+		add(record)
+	}
+	return records
+}
+
+// cacheVolumeStats returns the cache volume stats with help text and the
+// ones ATS reports, without the fragment counts and RAM cache lookups that
+// cacheVolumeFragsPerDoc and cacheVolumeRAMCacheLookups export.
+func cacheVolumeStats() []string {
+	stats := make([]string, 0, len(cacheVolumeHelp))
+	add := func(stat string) {
+		if !strings.HasPrefix(stat, "frags_per_doc.") && cacheVolumeRAMCacheResults[stat] == "" {
+			stats = append(stats, stat)
+		}
+	}
+	for stat := range cacheVolumeHelp {
+		add(stat)
+	}
+	for _, record := range atsRecords {
+		if m := cacheVolumeRecord.FindStringSubmatch(record); m != nil {
+			add(m[2])
+		}
+	}
+	return stats
+}
+
+// familyDescs are the descriptors of the metrics the families export.
+var familyDescs = []*prometheus.Desc{
+	managerStartTime,
+	proxyStartTime,
+	proxyCacheReadyTime,
+	proxyStopTime,
+	proxyRestarts,
+	cacheWarmup,
+	httpResponses,
+	httpResponsesByClass,
+	httpRequests,
+	httpTransactions,
+	httpTransactionsProcess,
+	httpMilestones,
+	httpPhases,
+	http2ClientSessions,
+	http2ClientStreams,
+	http2ClientStreamsTotal,
+	http2ClientConnections,
+	http2ConnectionErrors,
+	http2StreamErrors,
+	http2SessionDeaths,
+	http2TransactionsTime,
+	cacheResults,
+	cacheVolumeFragsPerDoc,
+	cacheVolumeRAMCacheLookups,
+	sslCipherHandshakes,
+	sslErrors,
+	sslRetries,
+	sslSessionCacheLookups,
+	sslSessionCacheNewSessions,
+	sslSessionCacheEvictions,
+	sslSessionCacheLockContentions,
+	sslUserAgentSessionLookups,
+	sslUserAgentSessions,
+	sslTickets,
+	sslTicketKeysRenewed,
+	sslResumptionRatio,
+	hostDBLookups,
+	hostDBHits,
+	hostDBHitRatio,
+	hostDBTTLExpires,
+	hostDBReDNSOnReload,
+	hostDBCacheItems,
+	hostDBCacheSize,
+	hostDBCacheInserts,
+	hostDBCacheLookups,
+	hostDBCacheHits,
+	hostDBCacheHitRatio,
+	hostDBCacheLastSync,
+	hostDBCacheLastSyncItems,
+	hostDBCacheLastSyncSize,
+	dnsLookups,
+	dnsLookupResults,
+	dnsRetries,
+	dnsMaxRetriesExceeded,
+	dnsInFlight,
+	dnsLookupTime,
+}
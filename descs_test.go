@@ -0,0 +1,49 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestDescribe(t *testing.T) {
+	ts := httptest.NewServer(http.FileServer(http.Dir("test")))
+	defer ts.Close()
+
+	for _, version := range []string{"7.1", "dynamic"} {
+		for _, naming := range []string{namingCurated, namingLegacy} {
+			module := defaultModule
+			module.Version = version
+			module.Naming = naming
+			c, err := NewTrafficServerCollector(ts.URL+"/trafficserver.json", module)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The pedantic registry fails the gather if a metric wasn't
+			// described or doesn't match its description.
+			registry := prometheus.NewPedanticRegistry()
+			if err := registry.Register(c); err != nil {
+				t.Fatalf("%s %s: %s", version, naming, err)
+			}
+			if _, err := registry.Gather(); err != nil {
+				t.Fatalf("%s %s: %s", version, naming, err)
+			}
+		}
+	}
+}
+
+func TestDescCache(t *testing.T) {
+	first := recordDescs.get("proxy.process.http.completed_requests")
+	if got := recordDescs.get("proxy.process.http.completed_requests"); got != first {
+		t.Errorf("got a new descriptor for the same record")
+	}
+
+	// Records that sanitize to the same name share a descriptor.
+	a := recordDescs.get("proxy.process.plugin.foo-bar")
+	if b := recordDescs.get("proxy.process.plugin.foo_bar"); a != b {
+		t.Errorf("got two descriptors for the same metric name")
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	hostDBLookupsRecord      = "proxy.process.hostdb.total_lookups"
+	hostDBHitsRecord         = "proxy.process.hostdb.total_hits"
+	hostDBCacheLookupsRecord = "proxy.process.hostdb.cache.total_lookups"
+	hostDBCacheHitsRecord    = "proxy.process.hostdb.cache.total_hits"
+)
+
+var (
+	hostDBLookups = prometheus.NewDesc(
+		"trafficserver_hostdb_lookups_total",
+		"Host name lookups in HostDB.",
+		nil, nil,
+	)
+	hostDBHits = prometheus.NewDesc(
+		"trafficserver_hostdb_hits_total",
+		"Host name lookups answered by HostDB without asking DNS.",
+		nil, nil,
+	)
+	hostDBHitRatio = prometheus.NewDesc(
+		"trafficserver_hostdb_hit_ratio",
+		"Share of the host name lookups answered by HostDB since start.",
+		nil, nil,
+	)
+	hostDBTTLExpires = prometheus.NewDesc(
+		"trafficserver_hostdb_ttl_expires_total",
+		"HostDB entries that expired.",
+		nil, nil,
+	)
+	hostDBReDNSOnReload = prometheus.NewDesc(
+		"trafficserver_hostdb_re_dns_on_reload_total",
+		"Host names looked up again because of a configuration reload.",
+		nil, nil,
+	)
+	hostDBCacheItems = prometheus.NewDesc(
+		"trafficserver_hostdb_cache_items",
+		"Entries in the HostDB cache.",
+		nil, nil,
+	)
+	hostDBCacheSize = prometheus.NewDesc(
+		"trafficserver_hostdb_cache_size_bytes",
+		"Size of the entries in the HostDB cache.",
+		nil, nil,
+	)
+	hostDBCacheInserts = prometheus.NewDesc(
+		"trafficserver_hostdb_cache_inserts_total",
+		"Inserts into the HostDB cache by result.",
+		[]string{"result"}, nil,
+	)
+	hostDBCacheLookups = prometheus.NewDesc(
+		"trafficserver_hostdb_cache_lookups_total",
+		"Lookups in the HostDB cache.",
+		nil, nil,
+	)
+	hostDBCacheHits = prometheus.NewDesc(
+		"trafficserver_hostdb_cache_hits_total",
+		"Lookups in the HostDB cache that found an entry.",
+		nil, nil,
+	)
+	hostDBCacheHitRatio = prometheus.NewDesc(
+		"trafficserver_hostdb_cache_hit_ratio",
+		"Share of the lookups in the HostDB cache that found an entry since start.",
+		nil, nil,
+	)
+	hostDBCacheLastSync = prometheus.NewDesc(
+		"trafficserver_hostdb_cache_last_sync_timestamp_seconds",
+		"Time the HostDB cache was last written to disk since unix epoch in seconds.",
+		nil, nil,
+	)
+	hostDBCacheLastSyncItems = prometheus.NewDesc(
+		"trafficserver_hostdb_cache_last_sync_items",
+		"Entries written by the last sync of the HostDB cache to disk.",
+		nil, nil,
+	)
+	hostDBCacheLastSyncSize = prometheus.NewDesc(
+		"trafficserver_hostdb_cache_last_sync_size_bytes",
+		"Bytes written by the last sync of the HostDB cache to disk.",
+		nil, nil,
+	)
+	dnsLookups = prometheus.NewDesc(
+		"trafficserver_dns_lookups_total",
+		"DNS lookups sent to the resolvers.",
+		nil, nil,
+	)
+	dnsLookupResults = prometheus.NewDesc(
+		"trafficserver_dns_lookup_results_total",
+		"Finished DNS lookups by result.",
+		[]string{"result"}, nil,
+	)
+	dnsRetries = prometheus.NewDesc(
+		"trafficserver_dns_retries_total",
+		"DNS lookups retried.",
+		nil, nil,
+	)
+	dnsMaxRetriesExceeded = prometheus.NewDesc(
+		"trafficserver_dns_max_retries_exceeded_total",
+		"DNS lookups given up on after proxy.config.dns.retries.",
+		nil, nil,
+	)
+	dnsInFlight = prometheus.NewDesc(
+		"trafficserver_dns_lookups_in_flight",
+		"DNS lookups waiting for an answer.",
+		nil, nil,
+	)
+	dnsLookupTime = prometheus.NewDesc(
+		"trafficserver_dns_lookup_avg_time_seconds",
+		"Average time of the DNS lookups by result, as computed by ATS.",
+		[]string{"result"}, nil,
+	)
+)
+
+// dnsLookupTimeRecords map the average lookup time records, in
+// milliseconds, to their result label.
+var dnsLookupTimeRecords = map[string]string{
+	"proxy.process.dns.lookup_avg_time":  "all",
+	"proxy.process.dns.success_avg_time": "success",
+	"proxy.process.dns.fail_avg_time":    "failure",
+}
+
+// collectDNS exports the HostDB and DNS resolver records. HostDB answers host
+// name lookups from its cache and only asks DNS on a miss, so a falling hit
+// ratio or growing lookup times show DNS stalls before they show up as slow
+// transactions.
+func collectDNS(records map[string]float64, ch chan<- prometheus.Metric) {
+	collectHitRatio(records, ch, hostDBHitRatio, hostDBHitsRecord, hostDBLookupsRecord)
+	collectHitRatio(records, ch, hostDBCacheHitRatio, hostDBCacheHitsRecord, hostDBCacheLookupsRecord)
+
+	collectSingle(records, ch, hostDBLookups, prometheus.CounterValue, hostDBLookupsRecord)
+	collectSingle(records, ch, hostDBHits, prometheus.CounterValue, hostDBHitsRecord)
+	collectSingle(records, ch, hostDBTTLExpires, prometheus.CounterValue, "proxy.process.hostdb.ttl_expires")
+	collectSingle(records, ch, hostDBReDNSOnReload, prometheus.CounterValue, "proxy.process.hostdb.re_dns_on_reload")
+	collectSingle(records, ch, hostDBCacheItems, prometheus.GaugeValue, "proxy.process.hostdb.cache.current_items")
+	collectSingle(records, ch, hostDBCacheSize, prometheus.GaugeValue, "proxy.process.hostdb.cache.current_size")
+	collectLabeled(records, ch, hostDBCacheInserts, prometheus.CounterValue, map[string]string{
+		"proxy.process.hostdb.cache.total_inserts":        "success",
+		"proxy.process.hostdb.cache.total_failed_inserts": "failure",
+	})
+	collectSingle(records, ch, hostDBCacheLookups, prometheus.CounterValue, hostDBCacheLookupsRecord)
+	collectSingle(records, ch, hostDBCacheHits, prometheus.CounterValue, hostDBCacheHitsRecord)
+	collectSingle(records, ch, hostDBCacheLastSync, prometheus.GaugeValue, "proxy.process.hostdb.cache.last_sync.time")
+	collectSingle(records, ch, hostDBCacheLastSyncItems, prometheus.GaugeValue, "proxy.process.hostdb.cache.last_sync.total_items")
+	collectSingle(records, ch, hostDBCacheLastSyncSize, prometheus.GaugeValue, "proxy.process.hostdb.cache.last_sync.total_size")
+
+	collectSingle(records, ch, dnsLookups, prometheus.CounterValue, "proxy.process.dns.total_dns_lookups")
+	collectLabeled(records, ch, dnsLookupResults, prometheus.CounterValue, map[string]string{
+		"proxy.process.dns.lookup_successes": "success",
+		"proxy.process.dns.lookup_failures":  "failure",
+	})
+	collectSingle(records, ch, dnsRetries, prometheus.CounterValue, "proxy.process.dns.retries")
+	collectSingle(records, ch, dnsMaxRetriesExceeded, prometheus.CounterValue, "proxy.process.dns.max_retries_exceeded")
+	collectSingle(records, ch, dnsInFlight, prometheus.GaugeValue, "proxy.process.dns.in_flight")
+	for record, result := range dnsLookupTimeRecords {
+		value, ok := records[record]
+		if !ok {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(dnsLookupTime, prometheus.GaugeValue, value*milliseconds.scale, result)
+		delete(records, record)
+	}
+}
+
+// collectHitRatio exports hits over lookups, unless there were no lookups
+// yet. It leaves both records in place.
+func collectHitRatio(records map[string]float64, ch chan<- prometheus.Metric, desc *prometheus.Desc, hitsRecord, lookupsRecord string) {
+	hits, hasHits := records[hitsRecord]
+	lookups, hasLookups := records[lookupsRecord]
+	if hasHits && hasLookups && lookups > 0 {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, hits/lookups)
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestDNS(t *testing.T) {
+	families := scrapeFixture(t, "dynamic")
+
+	for name, want := range map[string]float64{
+		"trafficserver_hostdb_lookups_total":                     5748579,
+		"trafficserver_hostdb_hit_ratio":                         5732103.0 / 5748579,
+		"trafficserver_hostdb_cache_hit_ratio":                   2322367.0 / 2846600,
+		"trafficserver_hostdb_cache_items":                       1,
+		"trafficserver_hostdb_cache_last_sync_timestamp_seconds": 1544831709,
+		"trafficserver_dns_lookups_total":                        36893,
+		"trafficserver_dns_retries_total":                        1,
+	} {
+		mf, ok := families[name]
+		if !ok {
+			t.Errorf("metric %s not exported", name)
+			continue
+		}
+		m := mf.GetMetric()[0]
+		if got := m.GetCounter().GetValue() + m.GetGauge().GetValue(); math.Abs(got-want) > 1e-9 {
+			t.Errorf("%s = %f, want %f", name, got, want)
+		}
+	}
+
+	results := labeledValues(families["trafficserver_dns_lookup_results_total"], "result")
+	if results["success"] != 16476 || results["failure"] != 0 || len(results) != 2 {
+		t.Errorf("got DNS lookup results %v", results)
+	}
+	times := labeledValues(families["trafficserver_dns_lookup_avg_time_seconds"], "result")
+	if len(times) != 3 {
+		t.Errorf("got DNS lookup times %v, want all, success and failure", times)
+	}
+
+	if _, ok := families["trafficserver_proxy_process_hostdb_total_lookups"]; ok {
+		t.Error("HostDB records are still exported on their own")
+	}
+}
+
+func TestDNSHitRatioWithoutLookups(t *testing.T) {
+	records := map[string]float64{
+		hostDBLookupsRecord: 0,
+		hostDBHitsRecord:    0,
+	}
+	ch := make(chan prometheus.Metric, 10)
+	collectDNS(records, ch)
+	close(ch)
+	for m := range ch {
+		if m.Desc() == hostDBHitRatio {
+			t.Error("hit ratio exported without lookups")
+		}
+	}
+}
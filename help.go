@@ -0,0 +1,538 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// recordHelp holds the help text of the records exported on their own, taken
+// from the ATS statistics documentation. The curated metrics of a single
+// record use it too, so it doesn't say percentage where those are ratios.
+// The records of a family and the proxy.node mirrors of proxy.process
+// records get theirs from derivedHelp instead.
+var recordHelp = map[string]string{
+	// Connections
+	"proxy.process.http.total_incoming_connections":        "Incoming connections accepted, HTTP and otherwise.",
+	"proxy.process.http.total_client_connections":          "Client connections accepted.",
+	"proxy.process.https.total_client_connections":         "Client TLS connections accepted.",
+	"proxy.process.http.total_server_connections":          "Connections made to origin servers.",
+	"proxy.process.http.total_parent_proxy_connections":    "Connections made to parent proxies.",
+	"proxy.process.http.broken_server_connections":         "Origin server connections that broke before the response was complete.",
+	"proxy.process.http.origin_connections_throttled_out":  "Origin server connections not made because of proxy.config.http.origin_max_connections.",
+	"proxy.process.http.current_client_connections":        "Open client connections.",
+	"proxy.process.http.current_active_client_connections": "Client connections with a transaction in progress.",
+	"proxy.process.http.current_server_connections":        "Open origin server connections.",
+	"proxy.process.http.current_parent_proxy_connections":  "Open parent proxy connections.",
+	"proxy.process.http.current_cache_connections":         "Open cache connections.",
+	"proxy.process.http.current_client_transactions":       "Client transactions in progress.",
+	"proxy.process.http.current_server_transactions":       "Origin server transactions in progress.",
+	"proxy.process.net.connections_currently_open":         "Open network connections.",
+	"proxy.process.net.accepts_currently_open":             "Listening sockets accepting connections.",
+
+	// Parent proxies
+	"proxy.process.http.total_parent_retries":              "Retries of requests to a parent proxy.",
+	"proxy.process.http.total_parent_switches":             "Requests moved on to the next parent proxy.",
+	"proxy.process.http.total_parent_retries_exhausted":    "Requests that failed after retrying all parent proxies.",
+	"proxy.process.http.total_parent_marked_down_count":    "Times a parent proxy was marked down.",
+	"proxy.process.http.parent_proxy_transaction_time":     "Total time spent in parent proxy transactions.",
+	"proxy.process.http.parent_proxy_request_total_bytes":  "Bytes sent to parent proxies, headers and bodies.",
+	"proxy.process.http.parent_proxy_response_total_bytes": "Bytes received from parent proxies, headers and bodies.",
+
+	// Requests
+	"proxy.process.http.completed_requests":      "Client requests ATS finished handling.",
+	"proxy.process.http.incoming_requests":       "Requests received from clients.",
+	"proxy.process.https.incoming_requests":      "Requests received from clients over TLS.",
+	"proxy.process.http.outgoing_requests":       "Requests sent to origin servers.",
+	"proxy.process.http.incoming_responses":      "Responses received from origin servers.",
+	"proxy.process.http.invalid_client_requests": "Client requests that could not be parsed.",
+	"proxy.process.http.missing_host_hdr":        "Client requests without a Host header.",
+	"proxy.process.http.post_body_too_large":     "POST requests rejected for exceeding proxy.config.http.post_copy_size.",
+	"proxy.process.http.tunnels":                 "Transactions tunneled through ATS without being looked at.",
+	"proxy.process.http.throttled_proxy_only":    "Requests throttled to proxy only mode because of too many connections.",
+	"proxy.process.http.total_transactions_time": "Total time spent in client transactions.",
+
+	// Sizes
+	"proxy.process.http.user_agent_request_header_total_size":       "Bytes of request headers received from clients.",
+	"proxy.process.http.user_agent_response_header_total_size":      "Bytes of response headers sent to clients.",
+	"proxy.process.http.user_agent_request_document_total_size":     "Bytes of request bodies received from clients.",
+	"proxy.process.http.user_agent_response_document_total_size":    "Bytes of response bodies sent to clients.",
+	"proxy.process.http.origin_server_request_header_total_size":    "Bytes of request headers sent to origin servers.",
+	"proxy.process.http.origin_server_response_header_total_size":   "Bytes of response headers received from origin servers.",
+	"proxy.process.http.origin_server_request_document_total_size":  "Bytes of request bodies sent to origin servers.",
+	"proxy.process.http.origin_server_response_document_total_size": "Bytes of response bodies received from origin servers.",
+	"proxy.process.http.pushed_response_header_total_size":          "Bytes of response headers pushed into the cache.",
+	"proxy.process.http.pushed_document_total_size":                 "Bytes of response bodies pushed into the cache.",
+	"proxy.process.net.read_bytes":                                  "Bytes read from the network.",
+	"proxy.process.net.write_bytes":                                 "Bytes written to the network.",
+
+	// Errors and aborts
+	"proxy.process.http.err_client_abort_count_stat":               "Transactions aborted by the client.",
+	"proxy.process.http.err_client_abort_user_agent_bytes_stat":    "Bytes sent to clients in transactions they aborted.",
+	"proxy.process.http.err_client_abort_origin_server_bytes_stat": "Bytes received from origin servers in transactions the client aborted.",
+	"proxy.process.http.err_connect_fail_count_stat":               "Transactions that failed to connect to the origin server.",
+	"proxy.process.http.err_connect_fail_user_agent_bytes_stat":    "Bytes sent to clients in transactions that failed to connect to the origin server.",
+	"proxy.process.http.err_connect_fail_origin_server_bytes_stat": "Bytes received from origin servers in transactions that failed to connect.",
+	"proxy.process.http.misc_count_stat":                           "Transactions that failed for other reasons.",
+	"proxy.process.http.misc_user_agent_bytes_stat":                "Bytes sent to clients in transactions that failed for other reasons.",
+	"proxy.process.http.http_misc_origin_server_bytes_stat":        "Bytes received from origin servers in transactions that failed for other reasons.",
+	"proxy.process.http.background_fill_bytes_aborted_stat":        "Bytes of background fills that were aborted.",
+	"proxy.process.http.background_fill_bytes_completed_stat":      "Bytes of background fills that completed.",
+	"proxy.process.http.background_fill_current_count":             "Background fills in progress.",
+
+	// Cache
+	"proxy.process.http.cache_lookups":          "Cache lookups.",
+	"proxy.process.http.cache_writes":           "Cache writes.",
+	"proxy.process.http.cache_updates":          "Cache updates.",
+	"proxy.process.http.cache_deletes":          "Cache deletes.",
+	"proxy.process.http.cache_read_errors":      "Cache read errors.",
+	"proxy.process.http.cache_write_errors":     "Cache write errors.",
+	"proxy.process.cache.bytes_used":            "Bytes of cache storage in use.",
+	"proxy.process.cache.bytes_total":           "Bytes of cache storage.",
+	"proxy.process.cache.ram_cache.bytes_used":  "Bytes of RAM cache in use.",
+	"proxy.process.cache.ram_cache.total_bytes": "Bytes of RAM cache.",
+	"proxy.process.cache.ram_cache.hits":        "RAM cache hits.",
+	"proxy.process.cache.ram_cache.misses":      "RAM cache misses.",
+	"proxy.process.cache.percent_full":          "Part of the cache storage in use.",
+	"proxy.process.cache.direntries.total":      "Cache directory entries.",
+	"proxy.process.cache.direntries.used":       "Cache directory entries in use.",
+	"proxy.node.cache.bytes_total":              "Bytes of cache storage.",
+	"proxy.node.cache.bytes_free":               "Bytes of cache storage not in use.",
+	"proxy.node.cache.percent_free":             "Part of the cache storage not in use.",
+	"proxy.node.cache_hit_ratio":                "Share of cache lookups that were hits since start.",
+	"proxy.node.cache_hit_mem_ratio":            "Share of cache lookups that were RAM cache hits since start.",
+	"proxy.node.bandwidth_hit_ratio":            "Share of bytes sent to clients that were served from cache since start.",
+
+	// Node
+	"proxy.node.proxy_running":                               "Whether traffic_server is running.",
+	"proxy.node.config.reconfigure_required":                 "Whether a configuration change needs a reload.",
+	"proxy.node.config.reconfigure_time":                     "Time of the last configuration reload since unix epoch in seconds.",
+	"proxy.node.config.restart_required.proxy":               "Whether a configuration change needs a restart of traffic_server.",
+	"proxy.node.config.restart_required.manager":             "Whether a configuration change needs a restart of traffic_manager.",
+	"proxy.node.http.user_agents_total_documents_served":     "Documents served to clients.",
+	"proxy.node.http.user_agents_total_transactions_count":   "Client transactions.",
+	"proxy.node.http.origin_server_total_transactions_count": "Origin server transactions.",
+
+	// DNS
+	"proxy.process.dns.in_flight":       "DNS lookups in progress.",
+	"proxy.process.dns.lookup_avg_time": "Average DNS lookup time in milliseconds.",
+
+	// Node
+	"proxy.node.cache.bytes_free_mb":                          "Megabytes of cache storage not in use.",
+	"proxy.node.cache.bytes_total_mb":                         "Megabytes of cache storage.",
+	"proxy.node.cache_total_hits":                             "Cache lookups that were hits.",
+	"proxy.node.cache_total_hits_mem":                         "Cache lookups that were RAM cache hits.",
+	"proxy.node.cache_total_misses":                           "Cache lookups that were misses.",
+	"proxy.node.client_throughput_out":                        "Throughput to clients in megabits per second.",
+	"proxy.node.client_throughput_out_kbit":                   "Throughput to clients in kilobits per second.",
+	"proxy.node.cluster.nodes":                                "Nodes in the cluster.",
+	"proxy.node.config.restart_required.cop":                  "Whether a configuration change needs a restart of traffic_cop.",
+	"proxy.node.current_cache_connections":                    "Open cache connections.",
+	"proxy.node.current_client_connections":                   "Open client connections.",
+	"proxy.node.current_server_connections":                   "Open origin server connections.",
+	"proxy.node.dns.lookups_per_second":                       "DNS lookups per second.",
+	"proxy.node.hostdb.hit_ratio":                             "Share of the host name lookups answered by HostDB since start.",
+	"proxy.node.http.cache_current_connections_count":         "Open cache connections.",
+	"proxy.node.http.origin_server_current_connections_count": "Open origin server connections.",
+	"proxy.node.http.origin_server_total_request_bytes":       "Bytes of requests sent to origin servers, headers and bodies.",
+	"proxy.node.http.origin_server_total_response_bytes":      "Bytes of responses received from origin servers, headers and bodies.",
+	"proxy.node.http.parent_proxy_total_request_bytes":        "Bytes sent to parent proxies, headers and bodies.",
+	"proxy.node.http.parent_proxy_total_response_bytes":       "Bytes received from parent proxies, headers and bodies.",
+	"proxy.node.http.throughput":                              "Client throughput, as computed by traffic_manager.",
+	"proxy.node.http.user_agent_current_connections_count":    "Open client connections.",
+	"proxy.node.http.user_agent_total_request_bytes":          "Bytes of requests received from clients, headers and bodies.",
+	"proxy.node.http.user_agent_total_response_bytes":         "Bytes of responses sent to clients, headers and bodies.",
+	"proxy.node.http.user_agent_xacts_per_second":             "Client transactions per second.",
+	"proxy.node.user_agent_xacts_per_second":                  "Client transactions per second.",
+	"proxy.node.origin_server_total_bytes":                    "Bytes sent to and received from origin servers.",
+	"proxy.node.user_agent_total_bytes":                       "Bytes sent to and received from clients.",
+	"proxy.node.user_agents_total_documents_served":           "Documents served to clients.",
+	"proxy.node.restarts.manager.start_time":                  "Time traffic_manager started since unix epoch in seconds.",
+	"proxy.node.restarts.proxy.start_time":                    "Time traffic_server started since unix epoch in seconds.",
+	"proxy.node.restarts.proxy.stop_time":                     "Time traffic_server last stopped since unix epoch in seconds.",
+	"proxy.node.restarts.proxy.cache_ready_time":              "Time the cache became ready since unix epoch in seconds.",
+	"proxy.node.restarts.proxy.restart_count":                 "Times traffic_manager restarted traffic_server.",
+
+	// HTTP
+	"proxy.process.http.avg_transactions_per_client_connection":      "Average transactions per client connection.",
+	"proxy.process.http.avg_transactions_per_server_connection":      "Average transactions per origin server connection.",
+	"proxy.process.http.cache_hit_fresh":                             "Client transactions served fresh from cache, RAM cache hits included.",
+	"proxy.process.http.cache_hit_mem_fresh":                         "Client transactions served fresh from the RAM cache.",
+	"proxy.process.http.cache_hit_revalidated":                       "Client transactions served from cache after revalidating with the origin server.",
+	"proxy.process.http.cache_hit_ims":                               "Client If-Modified-Since requests answered from cache.",
+	"proxy.process.http.cache_hit_stale_served":                      "Client transactions served stale from cache.",
+	"proxy.process.http.cache_miss_cold":                             "Cache misses for documents not in the cache.",
+	"proxy.process.http.cache_miss_changed":                          "Cache misses because the origin server had a changed document.",
+	"proxy.process.http.cache_miss_client_no_cache":                  "Cache misses because the client asked not to use the cache.",
+	"proxy.process.http.cache_miss_client_not_cacheable":             "Cache misses for client requests that can't be cached.",
+	"proxy.process.http.cache_miss_ims":                              "Client If-Modified-Since requests that missed the cache.",
+	"proxy.process.http.cache_read_error":                            "Client transactions that hit a cache read error.",
+	"proxy.process.http.extension_method_requests":                   "Client requests with a method ATS doesn't know.",
+	"proxy.process.http.disallowed_post_100_continue":                "POST requests with Expect: 100-continue that were rejected.",
+	"proxy.process.http.total_client_connections_ipv4":               "Client connections accepted over IPv4.",
+	"proxy.process.http.total_client_connections_ipv6":               "Client connections accepted over IPv6.",
+	"proxy.process.http.total_x_redirect_count":                      "Redirects ATS followed on behalf of clients.",
+	"proxy.process.http.websocket.current_active_client_connections": "Open client WebSocket connections.",
+
+	// HTTP/2
+	"proxy.process.http2.current_client_sessions":  "Open HTTP/2 client sessions.",
+	"proxy.process.http2.current_client_streams":   "Open HTTP/2 client streams.",
+	"proxy.process.http2.total_client_streams":     "HTTP/2 client streams opened.",
+	"proxy.process.http2.total_client_connections": "HTTP/2 client connections accepted.",
+	"proxy.process.http2.connection_errors":        "HTTP/2 connection errors.",
+	"proxy.process.http2.stream_errors":            "HTTP/2 stream errors.",
+	"proxy.process.http2.total_transactions_time":  "Total time spent in HTTP/2 transactions.",
+
+	// Cache
+	"proxy.process.cache.read_per_sec":     "Cache reads per second.",
+	"proxy.process.cache.write_per_sec":    "Cache writes per second.",
+	"proxy.process.cache.KB_read_per_sec":  "Kilobytes read from the cache per second.",
+	"proxy.process.cache.KB_write_per_sec": "Kilobytes written to the cache per second.",
+
+	// Cluster, which ATS 7 no longer supports but still reports
+	"proxy.process.cluster.alloc_data_news":                   "Cluster data buffers allocated.",
+	"proxy.process.cluster.byte_bank_used":                    "Cluster byte bank buffers in use.",
+	"proxy.process.cluster.cache_callback_time":               "Total time spent in cluster cache callbacks.",
+	"proxy.process.cluster.cache_callbacks":                   "Cluster cache callbacks.",
+	"proxy.process.cluster.cache_outstanding":                 "Cluster cache operations in progress.",
+	"proxy.process.cluster.chan_inuse":                        "Cluster channels in use.",
+	"proxy.process.cluster.cluster_ping_time":                 "Total time of the pings between cluster nodes.",
+	"proxy.process.cluster.configuration_changes":             "Cluster configuration changes.",
+	"proxy.process.cluster.connections_avg_time":              "Average time of the cluster connections.",
+	"proxy.process.cluster.connections_bumped":                "Cluster connections bumped.",
+	"proxy.process.cluster.connections_closed":                "Cluster connections closed.",
+	"proxy.process.cluster.connections_open":                  "Open cluster connections.",
+	"proxy.process.cluster.connections_opened":                "Cluster connections opened.",
+	"proxy.process.cluster.connections_read_locked":           "Reads of cluster connections that found them locked.",
+	"proxy.process.cluster.connections_write_locked":          "Writes to cluster connections that found them locked.",
+	"proxy.process.cluster.control_messages_avg_receive_time": "Average time to receive a cluster control message.",
+	"proxy.process.cluster.control_messages_avg_send_time":    "Average time to send a cluster control message.",
+	"proxy.process.cluster.control_messages_received":         "Cluster control messages received.",
+	"proxy.process.cluster.control_messages_sent":             "Cluster control messages sent.",
+	"proxy.process.cluster.delayed_reads":                     "Cluster reads that were delayed.",
+	"proxy.process.cluster.level1_bank":                       "Cluster level 1 bank buffers in use.",
+	"proxy.process.cluster.lkrmt_cache_callback_time":         "Total time spent in cluster cache callbacks for local lookups of remote documents.",
+	"proxy.process.cluster.lkrmt_cache_callbacks":             "Cluster cache callbacks for local lookups of remote documents.",
+	"proxy.process.cluster.local_connection_time":             "Total time of the local cluster connections.",
+	"proxy.process.cluster.local_connections_closed":          "Local cluster connections closed.",
+	"proxy.process.cluster.machines_allocated":                "Cluster machines allocated.",
+	"proxy.process.cluster.machines_freed":                    "Cluster machines freed.",
+	"proxy.process.cluster.multilevel_bank":                   "Cluster multilevel bank buffers in use.",
+	"proxy.process.cluster.net_backup":                        "Times the cluster network backed up.",
+	"proxy.process.cluster.no_remote_space":                   "Cluster operations that found no space on the remote node.",
+	"proxy.process.cluster.nodes":                             "Nodes in the cluster.",
+	"proxy.process.cluster.op_delayed_for_lock":               "Cluster operations delayed waiting for a lock.",
+	"proxy.process.cluster.open_delay_time":                   "Total time cluster opens were delayed.",
+	"proxy.process.cluster.open_delays":                       "Cluster opens that were delayed.",
+	"proxy.process.cluster.partial_reads":                     "Partial cluster reads.",
+	"proxy.process.cluster.partial_writes":                    "Partial cluster writes.",
+	"proxy.process.cluster.rdmsg_assemble_time":               "Total time spent assembling cluster read messages.",
+	"proxy.process.cluster.read_bytes":                        "Bytes read from cluster connections.",
+	"proxy.process.cluster.reads":                             "Reads from cluster connections.",
+	"proxy.process.cluster.remote_connection_time":            "Total time of the remote cluster connections.",
+	"proxy.process.cluster.remote_connections_closed":         "Remote cluster connections closed.",
+	"proxy.process.cluster.remote_op_reply_timeouts":          "Remote cluster operations whose reply timed out.",
+	"proxy.process.cluster.remote_op_timeouts":                "Remote cluster operations that timed out.",
+	"proxy.process.cluster.rmt_cache_callback_time":           "Total time spent in cluster cache callbacks for remote documents.",
+	"proxy.process.cluster.rmt_cache_callbacks":               "Cluster cache callbacks for remote documents.",
+	"proxy.process.cluster.setdata_no_cachevc":                "Cluster set data operations without a cache connection.",
+	"proxy.process.cluster.setdata_no_cluster":                "Cluster set data operations without a cluster connection.",
+	"proxy.process.cluster.setdata_no_clustervc":              "Cluster set data operations without a cluster virtual connection.",
+	"proxy.process.cluster.setdata_no_tunnel":                 "Cluster set data operations without a tunnel.",
+	"proxy.process.cluster.slow_ctrl_msgs_sent":               "Cluster control messages sent on the slow path.",
+	"proxy.process.cluster.vc_cache_insert_lock_misses":       "Cluster connection cache inserts that missed the lock.",
+	"proxy.process.cluster.vc_cache_inserts":                  "Cluster connection cache inserts.",
+	"proxy.process.cluster.vc_cache_lookup_hits":              "Cluster connection cache lookups that were hits.",
+	"proxy.process.cluster.vc_cache_lookup_lock_misses":       "Cluster connection cache lookups that missed the lock.",
+	"proxy.process.cluster.vc_cache_lookup_misses":            "Cluster connection cache lookups that were misses.",
+	"proxy.process.cluster.vc_cache_purges":                   "Cluster connection cache purges.",
+	"proxy.process.cluster.vc_cache_scan_lock_misses":         "Cluster connection cache scans that missed the lock.",
+	"proxy.process.cluster.vc_cache_scans":                    "Cluster connection cache scans.",
+	"proxy.process.cluster.vc_read_list_len":                  "Cluster connections waiting to read.",
+	"proxy.process.cluster.vc_write_list_len":                 "Cluster connections waiting to write.",
+	"proxy.process.cluster.vc_write_stall":                    "Cluster writes that stalled.",
+	"proxy.process.cluster.write_bb_mallocs":                  "Cluster write buffers allocated.",
+	"proxy.process.cluster.write_bytes":                       "Bytes written to cluster connections.",
+	"proxy.process.cluster.write_lock_misses":                 "Cluster writes that missed the lock.",
+	"proxy.process.cluster.writes":                            "Writes to cluster connections.",
+
+	// Congestion control
+	"proxy.process.congestion.congested_on_conn_failures":  "Requests refused because the origin server was congested by connection failures.",
+	"proxy.process.congestion.congested_on_max_connection": "Requests refused because the origin server reached its maximum connections.",
+
+	// DNS and HostDB
+	"proxy.process.dns.total_dns_lookups":              "DNS lookups sent to the resolvers.",
+	"proxy.process.dns.lookup_successes":               "DNS lookups that succeeded.",
+	"proxy.process.dns.lookup_failures":                "DNS lookups that failed.",
+	"proxy.process.dns.success_avg_time":               "Average time of the successful DNS lookups in milliseconds.",
+	"proxy.process.dns.fail_avg_time":                  "Average time of the failed DNS lookups in milliseconds.",
+	"proxy.process.dns.retries":                        "DNS lookups retried.",
+	"proxy.process.dns.max_retries_exceeded":           "DNS lookups given up on after proxy.config.dns.retries.",
+	"proxy.process.hostdb.total_lookups":               "Host name lookups in HostDB.",
+	"proxy.process.hostdb.total_hits":                  "Host name lookups answered by HostDB without asking DNS.",
+	"proxy.process.hostdb.ttl":                         "Time to live of the HostDB entries, as computed by ATS.",
+	"proxy.process.hostdb.ttl_expires":                 "HostDB entries that expired.",
+	"proxy.process.hostdb.re_dns_on_reload":            "Host names looked up again because of a configuration reload.",
+	"proxy.process.hostdb.cache.current_items":         "Entries in the HostDB cache.",
+	"proxy.process.hostdb.cache.current_size":          "Bytes of the entries in the HostDB cache.",
+	"proxy.process.hostdb.cache.total_inserts":         "Inserts into the HostDB cache.",
+	"proxy.process.hostdb.cache.total_failed_inserts":  "Inserts into the HostDB cache that failed.",
+	"proxy.process.hostdb.cache.total_lookups":         "Lookups in the HostDB cache.",
+	"proxy.process.hostdb.cache.total_hits":            "Lookups in the HostDB cache that found an entry.",
+	"proxy.process.hostdb.cache.last_sync.time":        "Time the HostDB cache was last written to disk since unix epoch in seconds.",
+	"proxy.process.hostdb.cache.last_sync.total_items": "Entries written by the last sync of the HostDB cache to disk.",
+	"proxy.process.hostdb.cache.last_sync.total_size":  "Bytes written by the last sync of the HostDB cache to disk.",
+
+	// Logging
+	"proxy.process.log.bytes_written_to_disk":             "Bytes of log entries written to disk.",
+	"proxy.process.log.bytes_flush_to_disk":               "Bytes of log entries flushed to disk.",
+	"proxy.process.log.bytes_sent_to_network":             "Bytes of log entries sent to a log collator.",
+	"proxy.process.log.bytes_received_from_network":       "Bytes of log entries received from other nodes as a log collator.",
+	"proxy.process.log.bytes_lost_before_preproc":         "Bytes of log entries lost before preprocessing.",
+	"proxy.process.log.bytes_lost_before_flush_to_disk":   "Bytes of log entries lost before they were flushed to disk.",
+	"proxy.process.log.bytes_lost_before_written_to_disk": "Bytes of log entries lost before they were written to disk.",
+	"proxy.process.log.bytes_lost_before_sent_to_network": "Bytes of log entries lost before they were sent to a log collator.",
+	"proxy.process.log.num_flush_to_disk":                 "Log entries flushed to disk.",
+	"proxy.process.log.num_sent_to_network":               "Log entries sent to a log collator.",
+	"proxy.process.log.num_received_from_network":         "Log entries received from other nodes as a log collator.",
+	"proxy.process.log.num_lost_before_flush_to_disk":     "Log entries lost before they were flushed to disk.",
+	"proxy.process.log.num_lost_before_sent_to_network":   "Log entries lost before they were sent to a log collator.",
+	"proxy.process.log.event_log_access_ok":               "Access log events logged.",
+	"proxy.process.log.event_log_access_skip":             "Access log events skipped by the log filters.",
+	"proxy.process.log.event_log_access_aggr":             "Access log events aggregated into other entries.",
+	"proxy.process.log.event_log_access_full":             "Access log events dropped because the log buffers were full.",
+	"proxy.process.log.event_log_access_fail":             "Access log events that failed to be logged.",
+	"proxy.process.log.event_log_error_ok":                "Error log events logged.",
+	"proxy.process.log.event_log_error_skip":              "Error log events skipped by the log filters.",
+	"proxy.process.log.event_log_error_aggr":              "Error log events aggregated into other entries.",
+	"proxy.process.log.event_log_error_full":              "Error log events dropped because the log buffers were full.",
+	"proxy.process.log.event_log_error_fail":              "Error log events that failed to be logged.",
+	"proxy.process.log.log_files_open":                    "Open log files.",
+	"proxy.process.log.log_files_space_used":              "Bytes of disk space used by the log files.",
+
+	// Network
+	"proxy.process.net.calls_to_read":                       "Read calls on network connections.",
+	"proxy.process.net.calls_to_read_nodata":                "Read calls on network connections that found no data.",
+	"proxy.process.net.calls_to_readfromnet":                "Reads from the network.",
+	"proxy.process.net.calls_to_readfromnet_afterpoll":      "Reads from the network after polling.",
+	"proxy.process.net.calls_to_write":                      "Write calls on network connections.",
+	"proxy.process.net.calls_to_write_nodata":               "Write calls on network connections that had no data to write.",
+	"proxy.process.net.calls_to_writetonet":                 "Writes to the network.",
+	"proxy.process.net.calls_to_writetonet_afterpoll":       "Writes to the network after polling.",
+	"proxy.process.net.default_inactivity_timeout_applied":  "Connections that got the default inactivity timeout.",
+	"proxy.process.net.dynamic_keep_alive_timeout_in_count": "Keep-alive connections whose timeout was adjusted dynamically.",
+	"proxy.process.net.dynamic_keep_alive_timeout_in_total": "Sum of the dynamically adjusted keep-alive timeouts.",
+	"proxy.process.net.fastopen_out.attempts":               "Origin server connections that tried TCP Fast Open.",
+	"proxy.process.net.fastopen_out.successes":              "Origin server connections made with TCP Fast Open.",
+	"proxy.process.net.inactivity_cop_lock_acquire_failure": "Times the inactivity check failed to get a connection's lock.",
+	"proxy.process.net.net_handler_run":                     "Runs of the network event loop.",
+	"proxy.process.socks.connections_currently_open":        "Open SOCKS connections.",
+	"proxy.process.socks.connections_successful":            "SOCKS connections that succeeded.",
+	"proxy.process.socks.connections_unsuccessful":          "SOCKS connections that failed.",
+
+	// TLS
+	"proxy.process.ssl.total_success_handshake_count":     "TLS handshakes that succeeded.",
+	"proxy.process.ssl.total_success_handshake_count_in":  "TLS handshakes with clients that succeeded.",
+	"proxy.process.ssl.total_success_handshake_count_out": "TLS handshakes with origin servers that succeeded.",
+	"proxy.process.ssl.total_handshake_time":              "Total time spent in TLS handshakes.",
+	"proxy.process.ssl.ssl_error_ssl":                     "OpenSSL SSL_ERROR_SSL errors, failures in the TLS protocol.",
+	"proxy.process.ssl.ssl_error_syscall":                 "OpenSSL SSL_ERROR_SYSCALL errors, I/O errors below TLS.",
+	"proxy.process.ssl.ssl_error_read_eos":                "TLS connections the peer closed without a TLS shutdown.",
+	"proxy.process.ssl.ssl_error_zero_return":             "TLS connections the peer shut down cleanly.",
+	"proxy.process.ssl.ssl_error_want_read":               "TLS reads and writes OpenSSL asked to retry once there is more to read.",
+	"proxy.process.ssl.ssl_error_want_write":              "TLS reads and writes OpenSSL asked to retry once it can write more.",
+	"proxy.process.ssl.ssl_error_want_x509_lookup":        "TLS handshakes OpenSSL paused for a certificate callback.",
+	"proxy.process.ssl.ssl_sni_name_set_failure":          "TLS client hellos whose server name could not be set.",
+	"proxy.process.ssl.ssl_ocsp_refreshed_cert":           "OCSP responses refreshed.",
+	"proxy.process.ssl.ssl_ocsp_refresh_cert_failure":     "OCSP responses that failed to refresh.",
+	"proxy.process.ssl.ssl_ocsp_revoked_cert_stat":        "Certificates OCSP reported as revoked.",
+	"proxy.process.ssl.ssl_ocsp_unknown_cert_stat":        "Certificates OCSP reported as unknown.",
+	"proxy.process.ssl.ssl_session_cache_hit":             "Lookups in the ATS TLS session cache that found the session.",
+	"proxy.process.ssl.ssl_session_cache_miss":            "Lookups in the ATS TLS session cache that didn't find the session.",
+	"proxy.process.ssl.ssl_session_cache_new_session":     "Sessions added to the ATS TLS session cache.",
+	"proxy.process.ssl.ssl_session_cache_eviction":        "Sessions evicted from the ATS TLS session cache.",
+	"proxy.process.ssl.ssl_session_cache_lock_contention": "Lookups in the ATS TLS session cache that hit a locked bucket.",
+	"proxy.process.ssl.user_agent_session_hit":            "Lookups in the OpenSSL client session cache that found the session.",
+	"proxy.process.ssl.user_agent_session_miss":           "Lookups in the OpenSSL client session cache that didn't find the session.",
+	"proxy.process.ssl.user_agent_session_timeout":        "Sessions in the OpenSSL client session cache that timed out.",
+	"proxy.process.ssl.user_agent_sessions":               "Sessions in the OpenSSL client session cache.",
+	"proxy.process.ssl.total_tickets_created":             "TLS session tickets created.",
+	"proxy.process.ssl.total_tickets_verified":            "TLS session tickets verified.",
+	"proxy.process.ssl.total_tickets_not_found":           "TLS session tickets presented with a key ATS doesn't have.",
+	"proxy.process.ssl.total_tickets_renewed":             "TLS session tickets renewed.",
+	"proxy.process.ssl.total_tickets_verified_old_key":    "TLS session tickets verified with an old key.",
+	"proxy.process.ssl.total_ticket_keys_renewed":         "Session ticket key renewals.",
+	"proxy.process.ssl.user_agent_bad_cert":               "TLS handshakes with clients that failed with a bad certificate.",
+	"proxy.process.ssl.user_agent_cert_verify_failed":     "TLS handshakes with clients that failed with a certificate that failed verification.",
+	"proxy.process.ssl.user_agent_decryption_failed":      "TLS handshakes with clients that failed with a failed decryption.",
+	"proxy.process.ssl.user_agent_expired_cert":           "TLS handshakes with clients that failed with an expired certificate.",
+	"proxy.process.ssl.user_agent_other_errors":           "TLS handshakes with clients that failed with another error.",
+	"proxy.process.ssl.user_agent_revoked_cert":           "TLS handshakes with clients that failed with a revoked certificate.",
+	"proxy.process.ssl.user_agent_unknown_ca":             "TLS handshakes with clients that failed with an unknown certificate authority.",
+	"proxy.process.ssl.user_agent_unknown_cert":           "TLS handshakes with clients that failed with an unknown certificate.",
+	"proxy.process.ssl.user_agent_wrong_version":          "TLS handshakes with clients that failed with a wrong protocol version.",
+	"proxy.process.ssl.origin_server_bad_cert":            "TLS handshakes with origin servers that failed with a bad certificate.",
+	"proxy.process.ssl.origin_server_cert_verify_failed":  "TLS handshakes with origin servers that failed with a certificate that failed verification.",
+	"proxy.process.ssl.origin_server_decryption_failed":   "TLS handshakes with origin servers that failed with a failed decryption.",
+	"proxy.process.ssl.origin_server_expired_cert":        "TLS handshakes with origin servers that failed with an expired certificate.",
+	"proxy.process.ssl.origin_server_other_errors":        "TLS handshakes with origin servers that failed with another error.",
+	"proxy.process.ssl.origin_server_revoked_cert":        "TLS handshakes with origin servers that failed with a revoked certificate.",
+	"proxy.process.ssl.origin_server_unknown_ca":          "TLS handshakes with origin servers that failed with an unknown certificate authority.",
+	"proxy.process.ssl.origin_server_unknown_cert":        "TLS handshakes with origin servers that failed with an unknown certificate.",
+	"proxy.process.ssl.origin_server_wrong_version":       "TLS handshakes with origin servers that failed with a wrong protocol version.",
+}
+
+// cacheVolumeHelp holds the help text of the per cache volume records, by
+// the record name without the proxy.process.cache.volume_N prefix.
+var cacheVolumeHelp = map[string]string{
+	"bytes_used":            "Bytes of storage in use in the cache volume.",
+	"bytes_total":           "Bytes of storage in the cache volume.",
+	"ram_cache.total_bytes": "Bytes of RAM cache for the cache volume.",
+	"ram_cache.bytes_used":  "Bytes of RAM cache in use for the cache volume.",
+	"ram_cache.hits":        "RAM cache hits in the cache volume.",
+	"ram_cache.misses":      "RAM cache misses in the cache volume.",
+	"pread_count":           "Reads from the cache volume done with pread.",
+	"percent_full":          "Part of the cache volume in use.",
+	"lookup.active":         "Lookups in progress in the cache volume.",
+	"lookup.success":        "Lookups in the cache volume that succeeded.",
+	"lookup.failure":        "Lookups in the cache volume that failed.",
+	"read.active":           "Reads in progress from the cache volume.",
+	"read.success":          "Reads from the cache volume that succeeded.",
+	"read.failure":          "Reads from the cache volume that failed.",
+	"write.active":          "Writes in progress to the cache volume.",
+	"write.success":         "Writes to the cache volume that succeeded.",
+	"write.failure":         "Writes to the cache volume that failed.",
+	"write.backlog.failure": "Writes to the cache volume that failed because of a full write backlog.",
+	"update.active":         "Updates in progress in the cache volume.",
+	"update.success":        "Updates in the cache volume that succeeded.",
+	"update.failure":        "Updates in the cache volume that failed.",
+	"remove.active":         "Removes in progress from the cache volume.",
+	"remove.success":        "Removes from the cache volume that succeeded.",
+	"remove.failure":        "Removes from the cache volume that failed.",
+	"evacuate.active":       "Evacuations in progress in the cache volume.",
+	"evacuate.success":      "Evacuations in the cache volume that succeeded.",
+	"evacuate.failure":      "Evacuations in the cache volume that failed.",
+	"scan.active":           "Scans in progress of the cache volume.",
+	"scan.success":          "Scans of the cache volume that succeeded.",
+	"scan.failure":          "Scans of the cache volume that failed.",
+	"direntries.total":      "Directory entries in the cache volume.",
+	"direntries.used":       "Directory entries in use in the cache volume.",
+	"directory_collision":   "Directory collisions in the cache volume.",
+	"read_busy.success":     "Reads from the cache volume that succeeded after it was busy.",
+	"read_busy.failure":     "Reads from the cache volume that failed because it was busy.",
+	"write_bytes_stat":      "Bytes written to the cache volume.",
+	"vector_marshals":       "Alternate vectors marshaled in the cache volume.",
+	"hdr_marshals":          "Headers marshaled in the cache volume.",
+	"hdr_marshal_bytes":     "Bytes of headers marshaled in the cache volume.",
+	"gc_bytes_evacuated":    "Bytes evacuated by garbage collection in the cache volume.",
+	"gc_frags_evacuated":    "Fragments evacuated by garbage collection in the cache volume.",
+	"wrap_count":            "Times writing wrapped around the cache volume.",
+	"sync.count":            "Directory syncs of the cache volume.",
+	"sync.bytes":            "Bytes written by directory syncs of the cache volume.",
+	"sync.time":             "Total time spent in directory syncs of the cache volume.",
+	"span.errors.read":      "Read errors on the disks of the cache volume.",
+	"span.errors.write":     "Write errors on the disks of the cache volume.",
+	"span.failing":          "Disks of the cache volume that are failing.",
+	"span.offline":          "Disks of the cache volume that are offline.",
+	"span.online":           "Disks of the cache volume that are online.",
+}
+
+var (
+	transactionHelpRecord  = regexp.MustCompile(`^proxy\.(?:process|node)\.http\.transaction_(counts|totaltime|counts_avg_10s|frac_avg_10s|msec_avg_10s)\.(.+?)(\.process)?$`)
+	documentSizeHelpRecord = regexp.MustCompile(`^proxy\.process\.http\.(request|response)_document_size_(.+)$`)
+	speedHelpRecord        = regexp.MustCompile(`^proxy\.process\.http\.(user_agent|origin_server)_speed_bytes_per_sec_(.+)$`)
+	cacheResultHelpRecord  = regexp.MustCompile(`^proxy\.process\.http\.tcp_(.+)_(count|user_agent_bytes|origin_server_bytes)_stat$`)
+	sessionDeathHelpRecord = regexp.MustCompile(`^proxy\.process\.http2\.session_die_(.+)$`)
+	fragmentsHelpRecord    = regexp.MustCompile(`^proxy\.process\.cache\.(?:volume_[0-9]+\.)?frags_per_doc\.(.+)$`)
+)
+
+// transactionHelp is the help text of the transaction records by kind, for
+// the outcome and whether the record is the .process one.
+var transactionHelp = map[string][2]string{
+	"counts":         {"Client transactions with the outcome %s.", "Client transactions with the outcome %s, counted again for their processing time."},
+	"totaltime":      {"Total time of the client transactions with the outcome %s.", "Part of the total time of the client transactions with the outcome %s that ATS spent processing them."},
+	"counts_avg_10s": {"Client transactions with the outcome %s over the last 10 seconds."},
+	"frac_avg_10s":   {"Share of the client transactions with the outcome %s over the last 10 seconds."},
+	"msec_avg_10s":   {"Average time of the client transactions with the outcome %s over the last 10 seconds."},
+}
+
+// recordHelpText returns the help text of a record exported on its own.
+// Records ATS adds in later versions get a generic one naming the record.
+func recordHelpText(record string) string {
+	if help, ok := derivedHelp(record); ok {
+		return help
+	}
+	return "Trafficserver metric " + record
+}
+
+// derivedHelp looks a record up in recordHelp, or derives its help text from
+// the family it belongs to, the cache volume help or the record it mirrors.
+func derivedHelp(record string) (string, bool) {
+	if help, ok := recordHelp[record]; ok {
+		return help, true
+	}
+	if m := transactionHelpRecord.FindStringSubmatch(record); m != nil {
+		help := transactionHelp[m[1]]
+		if m[3] != "" && help[1] != "" {
+			return fmt.Sprintf(help[1], m[2]), true
+		}
+		return fmt.Sprintf(help[0], m[2]+m[3]), true
+	}
+	if m := httpResponseRecord.FindStringSubmatch(record); m != nil {
+		return fmt.Sprintf("Responses with status code %s sent to clients.", m[1]), true
+	}
+	if m := httpResponseClassRecord.FindStringSubmatch(record); m != nil {
+		return fmt.Sprintf("Responses with a %s status code sent to clients, as counted by ATS.", m[1]), true
+	}
+	if m := httpMethodRecord.FindStringSubmatch(record); m != nil && !httpRequestTotals[m[1]] {
+		return fmt.Sprintf("Client requests with the method %s.", strings.ToUpper(m[1])), true
+	}
+	if m := httpMilestoneRecord.FindStringSubmatch(record); m != nil {
+		return fmt.Sprintf("Time from the start of each transaction to the %s milestone, summed up over all transactions.", m[1]), true
+	}
+	if m := documentSizeHelpRecord.FindStringSubmatch(record); m != nil {
+		peer := map[string]string{"request": "Requests from clients", "response": "Responses to clients"}[m[1]]
+		return fmt.Sprintf("%s whose body falls in the %s bucket of the document size histogram.", peer, m[2]), true
+	}
+	if m := speedHelpRecord.FindStringSubmatch(record); m != nil {
+		peer := map[string]string{"user_agent": "sent to clients", "origin_server": "received from origin servers"}[m[1]]
+		return fmt.Sprintf("Responses %s whose transfer speed falls in the %s bucket of the speed histogram.", peer, m[2]), true
+	}
+	if m := cacheResultHelpRecord.FindStringSubmatch(record); m != nil {
+		code := "TCP_" + strings.ToUpper(m[1])
+		switch m[2] {
+		case "count":
+			return fmt.Sprintf("Transactions logged with the cache result code %s.", code), true
+		case "user_agent_bytes":
+			return fmt.Sprintf("Bytes sent to clients in transactions logged as %s.", code), true
+		}
+		return fmt.Sprintf("Bytes received from origin servers in transactions logged as %s.", code), true
+	}
+	if strings.HasPrefix(record, sslCipherPrefix) {
+		return fmt.Sprintf("Client TLS handshakes that negotiated the cipher %s.", strings.TrimPrefix(record, sslCipherPrefix)), true
+	}
+	if m := sessionDeathHelpRecord.FindStringSubmatch(record); m != nil {
+		return fmt.Sprintf("HTTP/2 sessions closed for the reason %s.", m[1]), true
+	}
+	if m := fragmentsHelpRecord.FindStringSubmatch(record); m != nil {
+		return fmt.Sprintf("Documents in the cache made of %s fragments.", m[1]), true
+	}
+	if m := cacheVolumeRecord.FindStringSubmatch(record); m != nil {
+		if help, ok := cacheVolumeHelp[m[2]]; ok {
+			return strings.Replace(help, "the cache volume", "cache volume "+m[1], -1), true
+		}
+	}
+	if help, ok := cacheVolumeHelp[strings.TrimPrefix(record, "proxy.process.cache.")]; ok {
+		return strings.Replace(help, "the cache volume", "the cache", -1), true
+	}
+	if strings.HasSuffix(record, "_avg_10s") {
+		if help, ok := derivedHelp(strings.TrimSuffix(record, "_avg_10s")); ok {
+			help = strings.TrimSuffix(strings.TrimSuffix(help, "."), " since start")
+			return help + ", over the last 10 seconds.", true
+		}
+	}
+	if strings.HasPrefix(record, "proxy.node.") {
+		return derivedHelp("proxy.process." + strings.TrimPrefix(record, "proxy.node."))
+	}
+	return "", false
+}
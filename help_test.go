@@ -0,0 +1,52 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRecordHelp(t *testing.T) {
+	for version, profile := range profiles {
+		for record := range profile {
+			if help := recordHelpText(record); strings.HasPrefix(help, "Trafficserver metric ") {
+				t.Errorf("%s: record %s has no help text", version, record)
+			}
+		}
+	}
+	for _, record := range atsRecords {
+		if help := recordHelpText(record); strings.HasPrefix(help, "Trafficserver metric ") {
+			t.Errorf("record %s has no help text", record)
+		}
+	}
+
+	for _, version := range []string{"7.1", "dynamic"} {
+		for _, naming := range []string{namingCurated, namingLegacy} {
+			module := defaultModule
+			module.Version = version
+			module.Naming = naming
+			for name, mf := range scrapeModule(t, module) {
+				if help := mf.GetHelp(); strings.HasPrefix(help, "Trafficserver ") && strings.Contains(help, " metric ") {
+					t.Errorf("%s %s: %s has the generic help %q", version, naming, name, help)
+				}
+			}
+		}
+	}
+}
+
+func TestDerivedHelp(t *testing.T) {
+	for record, want := range map[string]string{
+		"proxy.process.http.404_responses":                      "Responses with status code 404 sent to clients.",
+		"proxy.process.http.get_requests":                       "Client requests with the method GET.",
+		"proxy.process.http.transaction_totaltime.hit_fresh":    "Total time of the client transactions with the outcome hit_fresh.",
+		"proxy.process.cache.volume_1.read.failure":             "Reads from cache volume 1 that failed.",
+		"proxy.process.cache.read.failure":                      "Reads from the cache that failed.",
+		"proxy.node.http.cache_hit_fresh_avg_10s":               "Client transactions served fresh from cache, RAM cache hits included, over the last 10 seconds.",
+		"proxy.process.http.tcp_hit_user_agent_bytes_stat":      "Bytes sent to clients in transactions logged as TCP_HIT.",
+		"proxy.process.ssl.cipher.user_agent.AES128-GCM-SHA256": "Client TLS handshakes that negotiated the cipher AES128-GCM-SHA256.",
+		"proxy.process.plugin.foo":                              "Trafficserver metric proxy.process.plugin.foo",
+	} {
+		if got := recordHelpText(record); got != want {
+			t.Errorf("%s: got %q, want %q", record, got, want)
+		}
+	}
+}
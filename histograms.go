@@ -0,0 +1,111 @@
+package main
+
+import (
+	"math"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// histogram turns a set of ATS bucket records into a native histogram. ATS
+// counts each event in exactly one bucket, so the buckets are summed up into
+// the cumulative le buckets Prometheus expects. ATS doesn't track the sum,
+// so it is exported as NaN.
+type histogram struct {
+	desc    *prometheus.Desc
+	prefix  string
+	buckets []histogramBucket
+}
+
+type histogramBucket struct {
+	suffix     string
+	upperBound float64
+}
+
+var documentSizeBuckets = []histogramBucket{
+	{"100", 100},
+	{"1K", 1024},
+	{"3K", 3 * 1024},
+	{"5K", 5 * 1024},
+	{"10K", 10 * 1024},
+	{"1M", 1024 * 1024},
+	{"inf", math.Inf(1)},
+}
+
+// ATS counts everything faster than 10M in the 100M bucket.
+var speedBuckets = []histogramBucket{
+	{"100", 100},
+	{"1K", 1024},
+	{"10K", 10 * 1024},
+	{"100K", 100 * 1024},
+	{"1M", 1024 * 1024},
+	{"10M", 10 * 1024 * 1024},
+	{"100M", math.Inf(1)},
+}
+
+var histograms = []histogram{
+	{
+		desc: prometheus.NewDesc(
+			"trafficserver_http_response_document_size_bytes",
+			"Size of the response bodies sent to clients.",
+			nil, nil,
+		),
+		prefix:  "proxy.process.http.response_document_size_",
+		buckets: documentSizeBuckets,
+	},
+	{
+		desc: prometheus.NewDesc(
+			"trafficserver_http_request_document_size_bytes",
+			"Size of the request bodies received from clients.",
+			nil, nil,
+		),
+		prefix:  "proxy.process.http.request_document_size_",
+		buckets: documentSizeBuckets,
+	},
+	{
+		desc: prometheus.NewDesc(
+			"trafficserver_http_user_agent_speed_bytes_per_second",
+			"Transfer speed of the responses sent to clients.",
+			nil, nil,
+		),
+		prefix:  "proxy.process.http.user_agent_speed_bytes_per_sec_",
+		buckets: speedBuckets,
+	},
+	{
+		desc: prometheus.NewDesc(
+			"trafficserver_http_origin_server_speed_bytes_per_second",
+			"Transfer speed of the responses received from origin servers.",
+			nil, nil,
+		),
+		prefix:  "proxy.process.http.origin_server_speed_bytes_per_sec_",
+		buckets: speedBuckets,
+	},
+}
+
+// collectHistograms exports the histograms whose bucket records are all
+// there and removes those records, so they aren't exported a second time.
+func collectHistograms(records map[string]float64, ch chan<- prometheus.Metric) {
+	for _, h := range histograms {
+		h.collect(records, ch)
+	}
+}
+
+func (h histogram) collect(records map[string]float64, ch chan<- prometheus.Metric) {
+	for _, b := range h.buckets {
+		if _, ok := records[h.prefix+b.suffix]; !ok {
+			return
+		}
+	}
+
+	var count uint64
+	buckets := make(map[float64]uint64, len(h.buckets))
+	for _, b := range h.buckets {
+		record := h.prefix + b.suffix
+		count += uint64(records[record])
+		delete(records, record)
+		if !math.IsInf(b.upperBound, 1) {
+			buckets[b.upperBound] = count
+		}
+	}
+
+	ch <- prometheus.MustNewConstHistogram(h.desc, count, math.NaN(), buckets)
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHistograms(t *testing.T) {
+	families := scrapeFixture(t, "dynamic")
+
+	mf, ok := families["trafficserver_http_response_document_size_bytes"]
+	if !ok {
+		t.Fatal("response document size histogram not exported")
+	}
+	h := mf.GetMetric()[0].GetHistogram()
+
+	if got, want := h.GetSampleCount(), uint64(8238891); got != want {
+		t.Errorf("got sample count %d, want %d", got, want)
+	}
+	if !math.IsNaN(h.GetSampleSum()) {
+		t.Errorf("got sample sum %g, want NaN", h.GetSampleSum())
+	}
+
+	want := []struct {
+		le    float64
+		count uint64
+	}{
+		{100, 22788},
+		{1024, 5738347},
+		{3072, 5764804},
+		{5120, 5836997},
+		{10240, 5846873},
+		{1048576, 8221309},
+	}
+	buckets := h.GetBucket()
+	if len(buckets) != len(want) {
+		t.Fatalf("got %d buckets, want %d", len(buckets), len(want))
+	}
+	for i, b := range buckets {
+		if b.GetUpperBound() != want[i].le || b.GetCumulativeCount() != want[i].count {
+			t.Errorf("bucket %d is le=%g count=%d, want le=%g count=%d",
+				i, b.GetUpperBound(), b.GetCumulativeCount(), want[i].le, want[i].count)
+		}
+	}
+
+	if _, ok := families["trafficserver_proxy_process_http_response_document_size_1k"]; ok {
+		t.Error("bucket records are still exported on their own")
+	}
+}
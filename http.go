@@ -0,0 +1,108 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	httpResponses = prometheus.NewDesc(
+		"trafficserver_http_responses_total",
+		"Responses sent to clients by status code.",
+		[]string{"code", "class"}, nil,
+	)
+	httpResponsesByClass = prometheus.NewDesc(
+		"trafficserver_http_responses_by_class_total",
+		"Responses sent to clients by status class, as counted by ATS. Includes codes that have no record of their own.",
+		[]string{"class"}, nil,
+	)
+	httpRequests = prometheus.NewDesc(
+		"trafficserver_http_requests_total",
+		"Requests received from clients by method. Methods ATS doesn't know are counted as OTHER.",
+		[]string{"method"}, nil,
+	)
+	httpTransactions = prometheus.NewDesc(
+		"trafficserver_transactions",
+		"Client transactions and their total time in seconds by outcome.",
+		[]string{"outcome"}, nil,
+	)
+	httpTransactionsProcess = prometheus.NewDesc(
+		"trafficserver_transactions_process",
+		"Client transactions and the part of their total time in seconds that ATS spent processing them, by outcome.",
+		[]string{"outcome"}, nil,
+	)
+
+	httpResponseRecord      = regexp.MustCompile(`^proxy\.process\.http\.(([1-5])[0-9][0-9])_responses$`)
+	httpResponseClassRecord = regexp.MustCompile(`^proxy\.process\.http\.([1-5]xx)_responses$`)
+	httpMethodRecord        = regexp.MustCompile(`^proxy\.process\.http\.([a-z]+)_requests$`)
+	httpTransactionRecord   = regexp.MustCompile(`^proxy\.process\.http\.transaction_counts\.(.+?)(\.process)?$`)
+)
+
+// httpRequestTotals are the *_requests records that count all requests
+// rather than one method.
+var httpRequestTotals = map[string]bool{
+	"completed": true,
+	"incoming":  true,
+	"outgoing":  true,
+}
+
+// collectHTTPResponses folds the per status code records into one labeled
+// metric. The Nxx aggregates go into a metric of their own, so that summing
+// up trafficserver_http_responses_total doesn't count responses twice.
+func collectHTTPResponses(records map[string]float64, ch chan<- prometheus.Metric) {
+	for record, value := range records {
+		if m := httpResponseRecord.FindStringSubmatch(record); m != nil {
+			ch <- prometheus.MustNewConstMetric(httpResponses, prometheus.CounterValue, value, m[1], m[2]+"xx")
+			delete(records, record)
+		} else if m := httpResponseClassRecord.FindStringSubmatch(record); m != nil {
+			ch <- prometheus.MustNewConstMetric(httpResponsesByClass, prometheus.CounterValue, value, m[1])
+			delete(records, record)
+		}
+	}
+}
+
+// collectHTTPRequests folds the per method request records into one labeled
+// metric.
+func collectHTTPRequests(records map[string]float64, ch chan<- prometheus.Metric) {
+	for record, value := range records {
+		var method string
+		if record == "proxy.process.http.extension_method_requests" {
+			method = "OTHER"
+		} else if m := httpMethodRecord.FindStringSubmatch(record); m != nil && !httpRequestTotals[m[1]] {
+			method = strings.ToUpper(m[1])
+		} else {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(httpRequests, prometheus.CounterValue, value, method)
+		delete(records, record)
+	}
+}
+
+// collectHTTPTransactions pairs up the transaction_counts and
+// transaction_totaltime records of each outcome into a summary, so the
+// average time per outcome is _sum / _count. ATS already reports the total
+// time in seconds. The .process records count the same transactions again,
+// so they go into a summary of their own to keep the outcomes adding up to
+// the completed transactions.
+func collectHTTPTransactions(records map[string]float64, ch chan<- prometheus.Metric) {
+	for record, count := range records {
+		m := httpTransactionRecord.FindStringSubmatch(record)
+		if m == nil {
+			continue
+		}
+		desc := httpTransactions
+		if m[2] != "" {
+			desc = httpTransactionsProcess
+		}
+		totalTime := "proxy.process.http.transaction_totaltime." + m[1] + m[2]
+		sum, ok := records[totalTime]
+		if !ok {
+			continue
+		}
+		ch <- prometheus.MustNewConstSummary(desc, uint64(count), sum, nil, m[1])
+		delete(records, record)
+		delete(records, totalTime)
+	}
+}
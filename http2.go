@@ -0,0 +1,74 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const http2TransactionsTimeRecord = "proxy.process.http2.total_transactions_time"
+
+var (
+	http2ClientSessions = prometheus.NewDesc(
+		"trafficserver_http2_client_sessions",
+		"Open HTTP/2 client sessions.",
+		nil, nil,
+	)
+	http2ClientStreams = prometheus.NewDesc(
+		"trafficserver_http2_client_streams",
+		"Open HTTP/2 client streams.",
+		nil, nil,
+	)
+	http2ClientStreamsTotal = prometheus.NewDesc(
+		"trafficserver_http2_client_streams_total",
+		"HTTP/2 client streams opened.",
+		nil, nil,
+	)
+	http2ClientConnections = prometheus.NewDesc(
+		"trafficserver_http2_client_connections_total",
+		"HTTP/2 client connections accepted.",
+		nil, nil,
+	)
+	http2ConnectionErrors = prometheus.NewDesc(
+		"trafficserver_http2_connection_errors_total",
+		"HTTP/2 connection errors.",
+		nil, nil,
+	)
+	http2StreamErrors = prometheus.NewDesc(
+		"trafficserver_http2_stream_errors_total",
+		"HTTP/2 stream errors.",
+		nil, nil,
+	)
+	http2SessionDeaths = prometheus.NewDesc(
+		"trafficserver_http2_session_deaths_total",
+		"HTTP/2 sessions closed by reason.",
+		[]string{"reason"}, nil,
+	)
+	http2TransactionsTime = prometheus.NewDesc(
+		"trafficserver_http2_transactions_time_seconds_total",
+		"Total time spent in HTTP/2 transactions.",
+		nil, nil,
+	)
+)
+
+// collectHTTP2 exports the HTTP/2 session and stream records.
+func collectHTTP2(records map[string]float64, ch chan<- prometheus.Metric) {
+	collectSingle(records, ch, http2ClientSessions, prometheus.GaugeValue, "proxy.process.http2.current_client_sessions")
+	collectSingle(records, ch, http2ClientStreams, prometheus.GaugeValue, "proxy.process.http2.current_client_streams")
+	collectSingle(records, ch, http2ClientStreamsTotal, prometheus.CounterValue, "proxy.process.http2.total_client_streams")
+	collectSingle(records, ch, http2ClientConnections, prometheus.CounterValue, "proxy.process.http2.total_client_connections")
+	collectSingle(records, ch, http2ConnectionErrors, prometheus.CounterValue, "proxy.process.http2.connection_errors")
+	collectSingle(records, ch, http2StreamErrors, prometheus.CounterValue, "proxy.process.http2.stream_errors")
+	collectLabeled(records, ch, http2SessionDeaths, prometheus.CounterValue, map[string]string{
+		"proxy.process.http2.session_die_default":  "default",
+		"proxy.process.http2.session_die_other":    "other",
+		"proxy.process.http2.session_die_eos":      "eos",
+		"proxy.process.http2.session_die_active":   "active",
+		"proxy.process.http2.session_die_inactive": "inactive",
+		"proxy.process.http2.session_die_error":    "error",
+	})
+
+	// ATS sums up the stream lifetimes in nanoseconds.
+	if value, ok := records[http2TransactionsTimeRecord]; ok {
+		ch <- prometheus.MustNewConstMetric(http2TransactionsTime, prometheus.CounterValue, value*nanoseconds.scale)
+		delete(records, http2TransactionsTimeRecord)
+	}
+}
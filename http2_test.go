@@ -0,0 +1,79 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestHTTP2(t *testing.T) {
+	records := map[string]float64{
+		"proxy.process.http2.current_client_sessions":  12,
+		"proxy.process.http2.current_client_streams":   30,
+		"proxy.process.http2.total_client_streams":     4000,
+		"proxy.process.http2.total_client_connections": 250,
+		"proxy.process.http2.connection_errors":        7,
+		"proxy.process.http2.stream_errors":            9,
+		"proxy.process.http2.session_die_default":      1,
+		"proxy.process.http2.session_die_other":        2,
+		"proxy.process.http2.session_die_eos":          3,
+		"proxy.process.http2.session_die_active":       4,
+		"proxy.process.http2.session_die_inactive":     5,
+		"proxy.process.http2.session_die_error":        6,
+		"proxy.process.http2.total_transactions_time":  2500000000,
+	}
+	ch := make(chan prometheus.Metric, len(records))
+	collectHTTP2(records, ch)
+	close(ch)
+
+	values := make(map[*prometheus.Desc]float64)
+	deaths := make(map[string]float64)
+	for m := range ch {
+		var pb dto.Metric
+		if err := m.Write(&pb); err != nil {
+			t.Fatal(err)
+		}
+		value := pb.GetGauge().GetValue() + pb.GetCounter().GetValue()
+		if m.Desc() == http2SessionDeaths {
+			deaths[pb.GetLabel()[0].GetValue()] = value
+			continue
+		}
+		values[m.Desc()] = value
+	}
+
+	for desc, want := range map[*prometheus.Desc]float64{
+		http2ClientSessions:     12,
+		http2ClientStreams:      30,
+		http2ClientStreamsTotal: 4000,
+		http2ClientConnections:  250,
+		http2ConnectionErrors:   7,
+		http2StreamErrors:       9,
+		// Nanoseconds to seconds.
+		http2TransactionsTime: 2.5,
+	} {
+		if got, ok := values[desc]; !ok || got != want {
+			t.Errorf("%s: got %g, want %g", desc, got, want)
+		}
+	}
+
+	for reason, want := range map[string]float64{
+		"default":  1,
+		"other":    2,
+		"eos":      3,
+		"active":   4,
+		"inactive": 5,
+		"error":    6,
+	} {
+		if got, ok := deaths[reason]; !ok || got != want {
+			t.Errorf("session deaths %s: got %g, want %g", reason, got, want)
+		}
+	}
+	if len(deaths) != 6 {
+		t.Errorf("got %d session death reasons, want 6", len(deaths))
+	}
+
+	if len(records) != 0 {
+		t.Errorf("HTTP/2 records were left over: %v", records)
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+// labeledValues returns the values of a metric family keyed by the value of
+// one of its labels.
+func labeledValues(mf *dto.MetricFamily, label string) map[string]float64 {
+	values := make(map[string]float64)
+	for _, m := range mf.GetMetric() {
+		for _, lp := range m.GetLabel() {
+			if lp.GetName() != label {
+				continue
+			}
+			switch {
+			case m.Counter != nil:
+				values[lp.GetValue()] = m.GetCounter().GetValue()
+			case m.Gauge != nil:
+				values[lp.GetValue()] = m.GetGauge().GetValue()
+			}
+		}
+	}
+	return values
+}
+
+func TestHTTPResponses(t *testing.T) {
+	families := scrapeFixture(t, "dynamic")
+
+	codes := labeledValues(families["trafficserver_http_responses_total"], "code")
+	if len(codes) != 39 {
+		t.Errorf("got %d status codes, want 39", len(codes))
+	}
+	for code, want := range map[string]float64{"200": 2520558, "206": 77, "404": 5654856, "502": 430} {
+		if got := codes[code]; got != want {
+			t.Errorf("code %s: got %g responses, want %g", code, got, want)
+		}
+	}
+	for _, m := range families["trafficserver_http_responses_total"].GetMetric() {
+		var code, class string
+		for _, lp := range m.GetLabel() {
+			switch lp.GetName() {
+			case "code":
+				code = lp.GetValue()
+			case "class":
+				class = lp.GetValue()
+			}
+		}
+		if class != code[:1]+"xx" {
+			t.Errorf("code %s has class %s", code, class)
+		}
+	}
+
+	classes := labeledValues(families["trafficserver_http_responses_by_class_total"], "class")
+	if got, want := classes["4xx"], float64(5717632); got != want {
+		t.Errorf("got %g 4xx responses, want %g", got, want)
+	}
+	if _, ok := families["trafficserver_proxy_process_http_404_responses"]; ok {
+		t.Error("status code records are still exported on their own")
+	}
+}
+
+func TestHTTPRequests(t *testing.T) {
+	families := scrapeFixture(t, "dynamic")
+
+	methods := labeledValues(families["trafficserver_http_requests_total"], "method")
+	want := map[string]float64{
+		"GET": 12600097, "HEAD": 8, "TRACE": 0, "OPTIONS": 0, "POST": 0, "PUT": 0,
+		"PUSH": 0, "DELETE": 0, "PURGE": 0, "CONNECT": 0, "OTHER": 0,
+	}
+	if len(methods) != len(want) {
+		t.Errorf("got methods %v, want %v", methods, want)
+	}
+	for method, count := range want {
+		if got, ok := methods[method]; !ok || got != count {
+			t.Errorf("method %s: got %g requests, want %g", method, got, count)
+		}
+	}
+	if _, ok := families["trafficserver_http_completed_requests_total"]; !ok {
+		t.Error("completed requests are no longer exported")
+	}
+}
+
+func TestHTTPTransactions(t *testing.T) {
+	families := scrapeFixture(t, "dynamic")
+
+	mf, ok := families["trafficserver_transactions"]
+	if !ok {
+		t.Fatal("transactions not exported")
+	}
+	if len(mf.GetMetric()) != 12 {
+		t.Errorf("got %d outcomes, want 12", len(mf.GetMetric()))
+	}
+	var total uint64
+	for _, m := range mf.GetMetric() {
+		s := m.GetSummary()
+		total += s.GetSampleCount()
+		if m.GetLabel()[0].GetValue() == "miss_changed" && (s.GetSampleCount() != 3 || s.GetSampleSum() != 0.381) {
+			t.Errorf("miss_changed: got count %d and sum %g, want 3 and 0.381", s.GetSampleCount(), s.GetSampleSum())
+		}
+	}
+	// All outcomes together are the completed transactions.
+	if completed := families["trafficserver_http_completed_requests_total"].GetMetric()[0].GetCounter().GetValue(); float64(total) != completed {
+		t.Errorf("outcomes add up to %d transactions, want %g", total, completed)
+	}
+
+	mf, ok = families["trafficserver_transactions_process"]
+	if !ok {
+		t.Fatal("transaction processing times not exported")
+	}
+	if len(mf.GetMetric()) != 1 {
+		t.Fatalf("got %d processing outcomes, want 1", len(mf.GetMetric()))
+	}
+	m := mf.GetMetric()[0]
+	if got := m.GetLabel()[0].GetValue(); got != "hit_fresh" {
+		t.Errorf("got processing outcome %q, want hit_fresh", got)
+	}
+	if s := m.GetSummary(); s.GetSampleCount() != 166739 || s.GetSampleSum() != 329627.875 {
+		t.Errorf("hit_fresh processing: got count %d and sum %g, want 166739 and 329627.875", s.GetSampleCount(), s.GetSampleSum())
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"regexp"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	httpMilestones = prometheus.NewDesc(
+		"trafficserver_http_milestone_seconds_total",
+		"Time from the start of each transaction to the milestone, summed up over all transactions.",
+		[]string{"milestone"}, nil,
+	)
+	httpPhases = prometheus.NewDesc(
+		"trafficserver_http_phase_seconds_total",
+		"Time spent in each phase of the transactions, derived from the milestones.",
+		[]string{"phase"}, nil,
+	)
+
+	httpMilestoneRecord = regexp.MustCompile(`^proxy\.process\.http\.milestone\.(.+)$`)
+)
+
+// httpPhaseMilestones maps each phase to the milestones it starts and ends
+// with.
+var httpPhaseMilestones = map[string][2]string{
+	"dns":              {"dns_lookup_begin", "dns_lookup_end"},
+	"origin_connect":   {"server_connect", "server_connect_end"},
+	"origin_ttfb":      {"server_begin_write", "server_first_read"},
+	"cache_open_read":  {"cache_open_read_begin", "cache_open_read_end"},
+	"cache_open_write": {"cache_open_write_begin", "cache_open_write_end"},
+}
+
+// collectMilestones exports the proxy.process.http.milestone records, which
+// ATS sums up in milliseconds, as one labeled metric in seconds. Since every
+// milestone counts from the start of the transaction, the difference of two
+// of them is the time spent in between, which is exported per phase.
+// Transactions that only reach the first milestone of a phase make that a
+// bit off, so phases that come out negative are left out.
+func collectMilestones(records map[string]float64, ch chan<- prometheus.Metric) {
+	milestones := make(map[string]float64)
+	for record, value := range records {
+		m := httpMilestoneRecord.FindStringSubmatch(record)
+		if m == nil {
+			continue
+		}
+		milestones[m[1]] = value * milliseconds.scale
+		ch <- prometheus.MustNewConstMetric(httpMilestones, prometheus.CounterValue, milestones[m[1]], m[1])
+		delete(records, record)
+	}
+
+	for phase, bounds := range httpPhaseMilestones {
+		begin, ok := milestones[bounds[0]]
+		if !ok {
+			continue
+		}
+		end, ok := milestones[bounds[1]]
+		if !ok || end < begin {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(httpPhases, prometheus.CounterValue, end-begin, phase)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMilestones(t *testing.T) {
+	families := scrapeFixture(t, "dynamic")
+
+	milestones := labeledValues(families["trafficserver_http_milestone_seconds_total"], "milestone")
+	if len(milestones) != 20 {
+		t.Errorf("got %d milestones, want 20", len(milestones))
+	}
+	if got := milestones["sm_finish"]; math.Abs(got-2172677.227) > 1e-6 {
+		t.Errorf("got sm_finish %f, want 2172677.227", got)
+	}
+
+	phases := labeledValues(families["trafficserver_http_phase_seconds_total"], "phase")
+	for phase, want := range map[string]float64{
+		"dns":              854807.215 - 854169.183,
+		"origin_connect":   907752.340 - 907205.687,
+		"origin_ttfb":      1814345.970 - 907791.204,
+		"cache_open_read":  854494.316 - 11.333,
+		"cache_open_write": 905686.622 - 854838.017,
+	} {
+		if got, ok := phases[phase]; !ok || math.Abs(got-want) > 1e-6 {
+			t.Errorf("phase %s: got %f, want %f", phase, got, want)
+		}
+	}
+
+	if _, ok := families["trafficserver_proxy_process_http_milestone_sm_finish_seconds_total"]; ok {
+		t.Error("milestone records are still exported on their own")
+	}
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The naming schemes a module can pick. Legacy exports every record in the
+// profile under its own name derived from the record name, with the value as
+// ATS reports it, like the exporter always did. Curated exports the families
+// and the curatedMetrics table, and only the records neither knows about
+// under their own name.
+const (
+	namingLegacy  = "legacy"
+	namingCurated = "curated"
+)
+
+// curatedMetric maps a set of ATS records onto one metric, each record to
+// its label values. The unit is the one ATS reports the records in, the name
+// already carries the base unit.
+type curatedMetric struct {
+	desc      *prometheus.Desc
+	valueType prometheus.ValueType
+	unit      unit
+	records   map[string][]string
+}
+
+func curated(name, help string, valueType prometheus.ValueType, u unit, labels []string, records map[string][]string) curatedMetric {
+	return curatedMetric{
+		desc:      prometheus.NewDesc(name, help, labels, nil),
+		valueType: valueType,
+		unit:      u,
+		records:   records,
+	}
+}
+
+// single is a curated metric for one record and no labels, with the help
+// text of the record.
+func single(record, name string, valueType prometheus.ValueType, u unit) curatedMetric {
+	return curated(name, recordHelpText(record), valueType, u, nil, map[string][]string{record: nil})
+}
+
+var noUnit = unit{"", 1, ""}
+
+// curatedMetrics is the naming table of the curated scheme, for the records
+// that aren't part of a family of their own.
+var curatedMetrics = []curatedMetric{
+	// Connections
+	single("proxy.process.http.total_incoming_connections", "trafficserver_incoming_connections_total", prometheus.CounterValue, noUnit),
+	curated("trafficserver_http_connections_total", "HTTP connections opened by side.", prometheus.CounterValue, noUnit, []string{"side"}, map[string][]string{
+		"proxy.process.http.total_client_connections":       {"client"},
+		"proxy.process.http.total_server_connections":       {"origin"},
+		"proxy.process.http.total_parent_proxy_connections": {"parent"},
+	}),
+	single("proxy.process.https.total_client_connections", "trafficserver_https_client_connections_total", prometheus.CounterValue, noUnit),
+	curated("trafficserver_http_connections", "Open HTTP connections by side.", prometheus.GaugeValue, noUnit, []string{"side"}, map[string][]string{
+		"proxy.process.http.current_client_connections":       {"client"},
+		"proxy.process.http.current_server_connections":       {"origin"},
+		"proxy.process.http.current_parent_proxy_connections": {"parent"},
+		"proxy.process.http.current_cache_connections":        {"cache"},
+	}),
+	single("proxy.process.http.current_active_client_connections", "trafficserver_http_active_client_connections", prometheus.GaugeValue, noUnit),
+	curated("trafficserver_http_transactions_in_progress", "HTTP transactions in progress by side.", prometheus.GaugeValue, noUnit, []string{"side"}, map[string][]string{
+		"proxy.process.http.current_client_transactions": {"client"},
+		"proxy.process.http.current_server_transactions": {"origin"},
+	}),
+	single("proxy.process.http.broken_server_connections", "trafficserver_http_broken_server_connections_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.http.origin_connections_throttled_out", "trafficserver_http_origin_connections_throttled_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.net.connections_currently_open", "trafficserver_net_connections", prometheus.GaugeValue, noUnit),
+	single("proxy.process.net.accepts_currently_open", "trafficserver_net_accepts", prometheus.GaugeValue, noUnit),
+	curated("trafficserver_net_bytes_total", "Bytes read from and written to the network.", prometheus.CounterValue, byteCount, []string{"direction"}, map[string][]string{
+		"proxy.process.net.read_bytes":  {"read"},
+		"proxy.process.net.write_bytes": {"write"},
+	}),
+
+	// Parent proxies
+	single("proxy.process.http.total_parent_retries", "trafficserver_http_parent_retries_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.http.total_parent_switches", "trafficserver_http_parent_switches_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.http.total_parent_retries_exhausted", "trafficserver_http_parent_retries_exhausted_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.http.total_parent_marked_down_count", "trafficserver_http_parent_marked_down_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.http.parent_proxy_transaction_time", "trafficserver_http_parent_proxy_transactions_time_seconds_total", prometheus.CounterValue, nanoseconds),
+	curated("trafficserver_http_parent_proxy_bytes_total", "Bytes sent to and received from parent proxies, headers and bodies.", prometheus.CounterValue, byteCount, []string{"message"}, map[string][]string{
+		"proxy.process.http.parent_proxy_request_total_bytes":  {"request"},
+		"proxy.process.http.parent_proxy_response_total_bytes": {"response"},
+	}),
+
+	// Requests
+	single("proxy.process.http.completed_requests", "trafficserver_http_completed_requests_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.http.incoming_requests", "trafficserver_http_incoming_requests_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.https.incoming_requests", "trafficserver_https_incoming_requests_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.http.outgoing_requests", "trafficserver_http_outgoing_requests_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.http.incoming_responses", "trafficserver_http_incoming_responses_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.http.invalid_client_requests", "trafficserver_http_invalid_client_requests_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.http.missing_host_hdr", "trafficserver_http_missing_host_header_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.http.post_body_too_large", "trafficserver_http_post_body_too_large_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.http.tunnels", "trafficserver_http_tunnels_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.http.throttled_proxy_only", "trafficserver_http_throttled_proxy_only_total", prometheus.CounterValue, noUnit),
+	single("proxy.process.http.total_transactions_time", "trafficserver_http_transactions_time_seconds_total", prometheus.CounterValue, nanoseconds),
+
+	// Sizes
+	curated("trafficserver_http_header_bytes_total", "Bytes of HTTP headers by side and message.", prometheus.CounterValue, byteCount, []string{"side", "message"}, map[string][]string{
+		"proxy.process.http.user_agent_request_header_total_size":     {"client", "request"},
+		"proxy.process.http.user_agent_response_header_total_size":    {"client", "response"},
+		"proxy.process.http.origin_server_request_header_total_size":  {"origin", "request"},
+		"proxy.process.http.origin_server_response_header_total_size": {"origin", "response"},
+	}),
+	curated("trafficserver_http_body_bytes_total", "Bytes of HTTP bodies by side and message.", prometheus.CounterValue, byteCount, []string{"side", "message"}, map[string][]string{
+		"proxy.process.http.user_agent_request_document_total_size":     {"client", "request"},
+		"proxy.process.http.user_agent_response_document_total_size":    {"client", "response"},
+		"proxy.process.http.origin_server_request_document_total_size":  {"origin", "request"},
+		"proxy.process.http.origin_server_response_document_total_size": {"origin", "response"},
+	}),
+	single("proxy.process.http.pushed_response_header_total_size", "trafficserver_http_pushed_header_bytes_total", prometheus.CounterValue, byteCount),
+	single("proxy.process.http.pushed_document_total_size", "trafficserver_http_pushed_body_bytes_total", prometheus.CounterValue, byteCount),
+
+	// Errors and aborts
+	curated("trafficserver_http_failed_transactions_total", "Transactions that failed by reason.", prometheus.CounterValue, noUnit, []string{"reason"}, map[string][]string{
+		"proxy.process.http.err_client_abort_count_stat": {"client_abort"},
+		"proxy.process.http.err_connect_fail_count_stat": {"connect_fail"},
+		"proxy.process.http.misc_count_stat":             {"other"},
+	}),
+	curated("trafficserver_http_failed_transaction_bytes_total", "Bytes transferred in transactions that failed by reason and side.", prometheus.CounterValue, byteCount, []string{"reason", "side"}, map[string][]string{
+		"proxy.process.http.err_client_abort_user_agent_bytes_stat":    {"client_abort", "client"},
+		"proxy.process.http.err_client_abort_origin_server_bytes_stat": {"client_abort", "origin"},
+		"proxy.process.http.err_connect_fail_user_agent_bytes_stat":    {"connect_fail", "client"},
+		"proxy.process.http.err_connect_fail_origin_server_bytes_stat": {"connect_fail", "origin"},
+		"proxy.process.http.misc_user_agent_bytes_stat":                {"other", "client"},
+		"proxy.process.http.http_misc_origin_server_bytes_stat":        {"other", "origin"},
+	}),
+	curated("trafficserver_http_background_fill_bytes_total", "Bytes of background fills by result.", prometheus.CounterValue, byteCount, []string{"result"}, map[string][]string{
+		"proxy.process.http.background_fill_bytes_aborted_stat":   {"aborted"},
+		"proxy.process.http.background_fill_bytes_completed_stat": {"completed"},
+	}),
+	single("proxy.process.http.background_fill_current_count", "trafficserver_http_background_fills", prometheus.GaugeValue, noUnit),
+
+	// Cache
+	curated("trafficserver_http_cache_operations_total", "Cache operations done for HTTP transactions.", prometheus.CounterValue, noUnit, []string{"operation"}, map[string][]string{
+		"proxy.process.http.cache_lookups": {"lookup"},
+		"proxy.process.http.cache_writes":  {"write"},
+		"proxy.process.http.cache_updates": {"update"},
+		"proxy.process.http.cache_deletes": {"delete"},
+	}),
+	curated("trafficserver_http_cache_errors_total", "Cache errors in HTTP transactions by operation.", prometheus.CounterValue, noUnit, []string{"operation"}, map[string][]string{
+		"proxy.process.http.cache_read_errors":  {"read"},
+		"proxy.process.http.cache_write_errors": {"write"},
+	}),
+	single("proxy.process.cache.bytes_used", "trafficserver_cache_used_bytes", prometheus.GaugeValue, byteCount),
+	single("proxy.process.cache.bytes_total", "trafficserver_cache_size_bytes", prometheus.GaugeValue, byteCount),
+	single("proxy.process.cache.percent_full", "trafficserver_cache_used_ratio", prometheus.GaugeValue, percent),
+	single("proxy.process.cache.ram_cache.bytes_used", "trafficserver_ram_cache_used_bytes", prometheus.GaugeValue, byteCount),
+	single("proxy.process.cache.ram_cache.total_bytes", "trafficserver_ram_cache_size_bytes", prometheus.GaugeValue, byteCount),
+	curated("trafficserver_ram_cache_lookups_total", "RAM cache lookups by result.", prometheus.CounterValue, noUnit, []string{"result"}, map[string][]string{
+		"proxy.process.cache.ram_cache.hits":   {"hit"},
+		"proxy.process.cache.ram_cache.misses": {"miss"},
+	}),
+	single("proxy.process.cache.direntries.total", "trafficserver_cache_directory_entries", prometheus.GaugeValue, noUnit),
+	single("proxy.process.cache.direntries.used", "trafficserver_cache_directory_entries_used", prometheus.GaugeValue, noUnit),
+
+	// Node
+	single("proxy.node.cache.bytes_total", "trafficserver_node_cache_size_bytes", prometheus.GaugeValue, byteCount),
+	single("proxy.node.cache.bytes_free", "trafficserver_node_cache_free_bytes", prometheus.GaugeValue, byteCount),
+	single("proxy.node.cache.percent_free", "trafficserver_node_cache_free_ratio", prometheus.GaugeValue, percent),
+	single("proxy.node.cache_hit_ratio", "trafficserver_node_cache_hit_ratio", prometheus.GaugeValue, noUnit),
+	single("proxy.node.cache_hit_mem_ratio", "trafficserver_node_cache_hit_mem_ratio", prometheus.GaugeValue, noUnit),
+	single("proxy.node.bandwidth_hit_ratio", "trafficserver_node_bandwidth_hit_ratio", prometheus.GaugeValue, noUnit),
+	single("proxy.node.proxy_running", "trafficserver_node_proxy_running", prometheus.GaugeValue, noUnit),
+	single("proxy.node.config.reconfigure_required", "trafficserver_node_reconfigure_required", prometheus.GaugeValue, noUnit),
+	single("proxy.node.config.reconfigure_time", "trafficserver_node_last_reconfigure_time_seconds", prometheus.GaugeValue, noUnit),
+	curated("trafficserver_node_restart_required", "Whether a configuration change needs a restart, by process.", prometheus.GaugeValue, noUnit, []string{"process"}, map[string][]string{
+		"proxy.node.config.restart_required.proxy":   {"traffic_server"},
+		"proxy.node.config.restart_required.manager": {"traffic_manager"},
+	}),
+	single("proxy.node.http.user_agents_total_documents_served", "trafficserver_node_documents_served_total", prometheus.CounterValue, noUnit),
+	curated("trafficserver_node_transactions_total", "Transactions by side.", prometheus.CounterValue, noUnit, []string{"side"}, map[string][]string{
+		"proxy.node.http.user_agents_total_transactions_count":   {"client"},
+		"proxy.node.http.origin_server_total_transactions_count": {"origin"},
+	}),
+}
+
+// curatedRecords are the records in curatedMetrics.
+var curatedRecords = func() map[string]bool {
+	records := make(map[string]bool)
+	for _, m := range curatedMetrics {
+		for record := range m.records {
+			records[record] = true
+		}
+	}
+	return records
+}()
+
+// collectCurated exports the records in the curatedMetrics table.
+func collectCurated(records map[string]float64, ch chan<- prometheus.Metric) {
+	for _, m := range curatedMetrics {
+		for record, labels := range m.records {
+			value, ok := records[record]
+			if !ok {
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(m.desc, m.valueType, value*m.unit.scale, labels...)
+			delete(records, record)
+		}
+	}
+}
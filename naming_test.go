@@ -0,0 +1,74 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestCuratedMetrics(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, m := range curatedMetrics {
+		name := m.desc.String()
+		if strings.Contains(name, `help: ""`) {
+			t.Errorf("%s: no help text, add one to recordHelp", name)
+		}
+		if total := strings.Contains(name, `_total"`); total != (m.valueType == prometheus.CounterValue) {
+			t.Errorf("%s: _total suffix doesn't match type %v", name, m.valueType)
+		}
+		for record := range m.records {
+			if seen[record] {
+				t.Errorf("record %s is in two curated metrics", record)
+			}
+			seen[record] = true
+		}
+	}
+}
+
+func TestCounterNames(t *testing.T) {
+	for _, version := range []string{"7.1", "dynamic"} {
+		for name, mf := range scrapeFixture(t, version) {
+			total := strings.HasSuffix(name, "_total")
+			if counter := mf.GetType() == dto.MetricType_COUNTER; counter && !total {
+				t.Errorf("%s: counter %s doesn't end in _total", version, name)
+			} else if !counter && total {
+				t.Errorf("%s: %s %s ends in _total", version, strings.ToLower(mf.GetType().String()), name)
+			}
+		}
+	}
+}
+
+func TestLegacyNaming(t *testing.T) {
+	module := defaultModule
+	module.Naming = namingLegacy
+	families := scrapeModule(t, module)
+
+	for _, name := range []string{
+		"trafficserver_proxy_process_http_completed_requests",
+		"trafficserver_proxy_process_http_200_responses",
+	} {
+		if _, ok := families[name]; !ok {
+			t.Errorf("metric %s not exported", name)
+		}
+	}
+
+	// As reported by ATS, without the base unit.
+	name := "trafficserver_proxy_process_http_total_transactions_time"
+	if mf, ok := families[name]; !ok {
+		t.Errorf("metric %s not exported", name)
+	} else if got := mf.GetMetric()[0].GetCounter().GetValue(); got != 2177141880861186 {
+		t.Errorf("%s = %f, want 2177141880861186", name, got)
+	}
+
+	for _, name := range []string{
+		"trafficserver_http_responses_total",
+		"trafficserver_http_completed_requests_total",
+		"trafficserver_cache_volume_used_bytes",
+	} {
+		if _, ok := families[name]; ok {
+			t.Errorf("metric %s exported with the legacy naming", name)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	proxyStartTimeRecord      = "proxy.node.restarts.proxy.start_time"
+	proxyCacheReadyTimeRecord = "proxy.node.restarts.proxy.cache_ready_time"
+)
+
+var (
+	managerStartTime = prometheus.NewDesc(
+		"trafficserver_manager_start_time_seconds",
+		"Start time of traffic_manager since unix epoch in seconds.",
+		nil, nil,
+	)
+	proxyStartTime = prometheus.NewDesc(
+		"trafficserver_proxy_start_time_seconds",
+		"Start time of traffic_server since unix epoch in seconds.",
+		nil, nil,
+	)
+	proxyCacheReadyTime = prometheus.NewDesc(
+		"trafficserver_proxy_cache_ready_time_seconds",
+		"Time the cache of traffic_server became ready since unix epoch in seconds.",
+		nil, nil,
+	)
+	proxyStopTime = prometheus.NewDesc(
+		"trafficserver_proxy_stop_time_seconds",
+		"Time traffic_server last stopped since unix epoch in seconds, 0 if it never did.",
+		nil, nil,
+	)
+	proxyRestarts = prometheus.NewDesc(
+		"trafficserver_proxy_restarts_total",
+		"Times traffic_manager started traffic_server.",
+		nil, nil,
+	)
+	buildInfo = prometheus.NewDesc(
+		"trafficserver_build_info",
+		"A metric with a constant '1' value labeled by the ATS version, build and host.",
+		[]string{"version", "build_number", "build_date", "hostname", "uuid"}, nil,
+	)
+	cacheWarmup = prometheus.NewDesc(
+		"trafficserver_cache_warmup_seconds",
+		"Time it took the cache to become ready after traffic_server last started.",
+		nil, nil,
+	)
+)
+
+// buildInfoRecords lists the string records each build info label is taken
+// from, in order of preference. traffic_server's own version comes before
+// traffic_manager's.
+var buildInfoRecords = [][]string{
+	{"proxy.process.version.server.short", "server", "proxy.node.version.manager.short"},
+	{"proxy.process.version.server.build_number", "proxy.node.version.manager.build_number"},
+	{"proxy.process.version.server.build_date", "proxy.node.version.manager.build_date"},
+	{"proxy.node.hostname_FQ", "proxy.node.hostname"},
+	{"proxy.process.version.server.uuid"},
+}
+
+// collectBuildInfo exports the version strings and hostname, which the
+// numeric records leave out. Nothing is exported if none of them are there.
+func collectBuildInfo(strs map[string]string, ch chan<- prometheus.Metric) {
+	labels := make([]string, len(buildInfoRecords))
+	found := false
+	for i, records := range buildInfoRecords {
+		for _, record := range records {
+			if value := strs[record]; value != "" {
+				labels[i] = value
+				found = true
+				break
+			}
+		}
+	}
+	if !found {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(buildInfo, prometheus.GaugeValue, 1, labels...)
+}
+
+// collectRestarts exports the process start and stop times, along with how
+// long the cache took to initialize. The warmup time is left out while the
+// cache isn't ready yet, i.e. its ready time predates the last start.
+func collectRestarts(records map[string]float64, ch chan<- prometheus.Metric) {
+	start, hasStart := records[proxyStartTimeRecord]
+	ready, hasReady := records[proxyCacheReadyTimeRecord]
+	if hasStart && hasReady && start > 0 && ready >= start {
+		ch <- prometheus.MustNewConstMetric(cacheWarmup, prometheus.GaugeValue, ready-start)
+	}
+
+	collectSingle(records, ch, managerStartTime, prometheus.GaugeValue, "proxy.node.restarts.manager.start_time")
+	collectSingle(records, ch, proxyStartTime, prometheus.GaugeValue, proxyStartTimeRecord)
+	collectSingle(records, ch, proxyCacheReadyTime, prometheus.GaugeValue, proxyCacheReadyTimeRecord)
+	collectSingle(records, ch, proxyStopTime, prometheus.GaugeValue, "proxy.node.restarts.proxy.stop_time")
+	collectSingle(records, ch, proxyRestarts, prometheus.CounterValue, "proxy.node.restarts.proxy.restart_count")
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestRestarts(t *testing.T) {
+	families := scrapeFixture(t, "dynamic")
+
+	for name, want := range map[string]float64{
+		"trafficserver_proxy_start_time_seconds":       1539589015,
+		"trafficserver_proxy_cache_ready_time_seconds": 1539589019,
+		"trafficserver_cache_warmup_seconds":           4,
+	} {
+		mf, ok := families[name]
+		if !ok {
+			t.Errorf("metric %s not exported", name)
+			continue
+		}
+		if got := mf.GetMetric()[0].GetGauge().GetValue(); got != want {
+			t.Errorf("%s: got %g, want %g", name, got, want)
+		}
+	}
+}
+
+func TestBuildInfo(t *testing.T) {
+	families := scrapeFixture(t, "dynamic")
+
+	mf, ok := families["trafficserver_build_info"]
+	if !ok {
+		t.Fatal("build info not exported")
+	}
+	labels := make(map[string]string)
+	for _, lp := range mf.GetMetric()[0].GetLabel() {
+		labels[lp.GetName()] = lp.GetValue()
+	}
+	for name, want := range map[string]string{
+		"version":      "7.1.1",
+		"build_number": "120511",
+		"build_date":   "Dec  5 2017",
+		"hostname":     "p4904937.pubip.peer1.net",
+		"uuid":         "3e782b38-9c70-40a5-881f-382dc60976cd",
+	} {
+		if got := labels[name]; got != want {
+			t.Errorf("label %s is %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/log"
+)
+
+var snapshotAge = prometheus.NewDesc(
+	"trafficserver_exporter_snapshot_age_seconds",
+	"Time since the stats served were scraped, only when scraping in the background.",
+	nil, nil,
+)
+
+// snapshot is the outcome of one scrape of the stats endpoint.
+type snapshot struct {
+	time     time.Time
+	duration time.Duration
+	// reason is one of scrapeErrorReasons when the scrape failed, the
+	// fields below size are only set when it didn't. size is also set when
+	// the body failed to decode.
+	reason  string
+	size    int
+	records map[string]float64
+	strs    map[string]string
+}
+
+// poll scrapes and parses the stats once.
+func (c *TrafficServerCollector) poll() *snapshot {
+	start := time.Now()
+	metrics, size, reason := c.scrape()
+	c.scrapes.Inc()
+
+	s := &snapshot{
+		time:     time.Now(),
+		duration: time.Since(start),
+		reason:   reason,
+		size:     size,
+	}
+	if reason != "" {
+		return s
+	}
+
+	records, strs, errs := parseRecords(metrics.Global)
+	for _, record := range errs {
+		log.Debugf("Skipping %s, its value %s is not a number", record, metrics.Global[record])
+		c.parseErrors.WithLabelValues(record).Inc()
+	}
+	s.records, s.strs = records, strs
+	return s
+}
+
+// StartPolling scrapes the stats endpoint every interval in the background
+// and makes Collect serve the last good scrape instead of scraping itself,
+// so any number of Prometheus servers cost one scrape of ATS per interval.
+// Once the last good scrape is older than maxAge, up goes to 0 and no
+// records are exported until a scrape succeeds again. It has to be called
+// before the collector is registered, and StopPolling ends it.
+func (c *TrafficServerCollector) StartPolling(interval, maxAge time.Duration) {
+	c.polling = true
+	c.maxAge = maxAge
+	c.stop = make(chan struct{})
+	c.stopped = make(chan struct{})
+
+	c.update(c.poll())
+	go func() {
+		defer close(c.stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.update(c.poll())
+			case <-c.stop:
+				return
+			}
+		}
+	}()
+}
+
+// StopPolling ends the background scrapes started by StartPolling and waits
+// for a running one to finish. Collect keeps serving the last snapshot, which
+// goes stale after maxAge.
+func (c *TrafficServerCollector) StopPolling() {
+	if c.stop == nil {
+		return
+	}
+	close(c.stop)
+	<-c.stopped
+	c.stop = nil
+}
+
+func (c *TrafficServerCollector) update(s *snapshot) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.last = s
+	if s.reason == "" {
+		c.good = s
+	}
+}
+
+// snapshots returns the last scrape and the last good one, scraping first
+// unless polling in the background.
+func (c *TrafficServerCollector) snapshots() (last, good *snapshot) {
+	if !c.polling {
+		last = c.poll()
+		if last.reason == "" {
+			good = last
+		}
+		return last, good
+	}
+
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.last, c.good
+}
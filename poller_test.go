@@ -0,0 +1,146 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestPolling(t *testing.T) {
+	var hits int32
+	files := http.FileServer(http.Dir("test"))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		files.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	gather := func(maxAge time.Duration) map[string]*dto.MetricFamily {
+		c, err := NewTrafficServerCollector(ts.URL+"/trafficserver.json", defaultModule)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.StartPolling(time.Hour, maxAge)
+		defer c.StopPolling()
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(c)
+
+		var families map[string]*dto.MetricFamily
+		for i := 0; i < 2; i++ {
+			mfs, err := registry.Gather()
+			if err != nil {
+				t.Fatal(err)
+			}
+			families = make(map[string]*dto.MetricFamily, len(mfs))
+			for _, mf := range mfs {
+				families[mf.GetName()] = mf
+			}
+		}
+		return families
+	}
+
+	// Gathering twice serves the same snapshot, records included.
+	families := gather(time.Hour)
+	if got := atomic.LoadInt32(&hits); got != 1 {
+		t.Errorf("got %d scrapes of ATS, want 1", got)
+	}
+	if got := families["trafficserver_up"].GetMetric()[0].GetGauge().GetValue(); got != 1 {
+		t.Errorf("got up %g, want 1", got)
+	}
+	if _, ok := families["trafficserver_exporter_snapshot_age_seconds"]; !ok {
+		t.Errorf("snapshot age not exported")
+	}
+	if _, ok := families["trafficserver_http_responses_total"]; !ok {
+		t.Errorf("records not exported from the cached snapshot")
+	}
+
+	// Past the max age the snapshot is stale.
+	families = gather(time.Nanosecond)
+	if got := families["trafficserver_up"].GetMetric()[0].GetGauge().GetValue(); got != 0 {
+		t.Errorf("got up %g for a stale snapshot, want 0", got)
+	}
+	if _, ok := families["trafficserver_http_responses_total"]; ok {
+		t.Errorf("records exported from a stale snapshot")
+	}
+}
+
+func TestStopPolling(t *testing.T) {
+	var hits int32
+	files := http.FileServer(http.Dir("test"))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		files.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	c, err := NewTrafficServerCollector(ts.URL+"/trafficserver.json", defaultModule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StartPolling(time.Millisecond, 0)
+	for atomic.LoadInt32(&hits) < 3 {
+		time.Sleep(time.Millisecond)
+	}
+	c.StopPolling()
+
+	stopped := atomic.LoadInt32(&hits)
+	time.Sleep(20 * time.Millisecond)
+	if got := atomic.LoadInt32(&hits); got != stopped {
+		t.Errorf("got %d scrapes of ATS after stopping, want none", got-stopped)
+	}
+
+	// Stopping twice is fine.
+	c.StopPolling()
+}
+
+func TestPollingUndecodableBody(t *testing.T) {
+	const body = `{"global": {"proxy.process.http.completed_requests": 1`
+	var broken int32
+	files := http.FileServer(http.Dir("test"))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&broken) == 1 {
+			w.Write([]byte(body))
+			return
+		}
+		files.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	c, err := NewTrafficServerCollector(ts.URL+"/trafficserver.json", defaultModule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.StartPolling(time.Hour, time.Hour)
+	defer c.StopPolling()
+
+	// The next scrape gets a body cut short, the good snapshot is still
+	// served but the size is the one of the broken body.
+	atomic.StoreInt32(&broken, 1)
+	c.update(c.poll())
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(c)
+	mfs, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	families := make(map[string]*dto.MetricFamily, len(mfs))
+	for _, mf := range mfs {
+		families[mf.GetName()] = mf
+	}
+
+	if got := families["trafficserver_exporter_response_size_bytes"].GetMetric()[0].GetGauge().GetValue(); got != float64(len(body)) {
+		t.Errorf("got response size %g, want %d", got, len(body))
+	}
+	if got := labeledValues(families["trafficserver_exporter_last_scrape_error"], "reason")["decode"]; got != 1 {
+		t.Errorf("got last scrape error %g for decode, want 1", got)
+	}
+	if got := families["trafficserver_up"].GetMetric()[0].GetGauge().GetValue(); got != 1 {
+		t.Errorf("got up %g while the good snapshot is fresh, want 1", got)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// targetURI turns a probe target into a stats_over_http URI. Bare
+// host[:port] targets get the http scheme and the module's path; full URLs
+// only get the module's path when they don't carry one of their own.
+func targetURI(target string, module Module) (string, error) {
+	if !strings.Contains(target, "://") {
+		target = "http://" + target
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return "", err
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = module.Path
+	}
+	return u.String(), nil
+}
+
+// probeHandler scrapes the requested target with a fresh collector in a
+// fresh registry, so one exporter can serve a whole fleet of servers.
+func probeHandler(w http.ResponseWriter, r *http.Request, sc *SafeConfig) {
+	params := r.URL.Query()
+
+	target := params.Get("target")
+	if target == "" {
+		http.Error(w, "Target parameter is missing", http.StatusBadRequest)
+		return
+	}
+
+	moduleName := params.Get("module")
+	if moduleName == "" {
+		moduleName = "default"
+	}
+	module, ok := sc.Module(moduleName)
+	if !ok {
+		http.Error(w, fmt.Sprintf("Unknown module %q", moduleName), http.StatusBadRequest)
+		return
+	}
+
+	uri, err := targetURI(target, module)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid target %q: %s", target, err), http.StatusBadRequest)
+		return
+	}
+	c, err := NewTrafficServerCollector(uri, module)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(c)
+	promhttp.HandlerFor(registry, promhttp.HandlerOpts{}).ServeHTTP(w, r)
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestTargetURI(t *testing.T) {
+	module := defaultModule
+	module.Path = "/_stats"
+
+	for target, want := range map[string]string{
+		"ats01.example.com:8080":                   "http://ats01.example.com:8080/_stats",
+		"ats01.example.com":                        "http://ats01.example.com/_stats",
+		"https://ats01.example.com":                "https://ats01.example.com/_stats",
+		"https://ats01.example.com/":               "https://ats01.example.com/_stats",
+		"https://ats01.example.com:8443/secret":    "https://ats01.example.com:8443/secret",
+		"http://ats01.example.com/stats?fmt=json":  "http://ats01.example.com/stats?fmt=json",
+		"ats01.example.com:8080/custom/stats/path": "http://ats01.example.com:8080/custom/stats/path",
+	} {
+		got, err := targetURI(target, module)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", target, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("targetURI(%q) = %q, want %q", target, got, want)
+		}
+	}
+}
+
+func TestProbeHandler(t *testing.T) {
+	ts := httptest.NewServer(http.FileServer(http.Dir("test")))
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+
+	module := defaultModule
+	module.Path = "/trafficserver.json"
+	sc := NewSafeConfig(map[string]Module{"default": module})
+
+	for _, tc := range []struct {
+		query string
+		code  int
+		body  string
+	}{
+		{"", http.StatusBadRequest, "Target parameter is missing"},
+		{"target=" + host + "&module=nope", http.StatusBadRequest, `Unknown module "nope"`},
+		{"target=ftp://" + host, http.StatusBadRequest, "scheme must be http or https"},
+		{"target=" + host, http.StatusOK, "trafficserver_up 1"},
+		{"target=" + url.QueryEscape(ts.URL+"/trafficserver.json") + "&module=default", http.StatusOK, "trafficserver_up 1"},
+		{"target=" + url.QueryEscape(ts.URL+"/missing.json"), http.StatusOK, "trafficserver_up 0"},
+	} {
+		w := httptest.NewRecorder()
+		probeHandler(w, httptest.NewRequest("GET", "/probe?"+tc.query, nil), sc)
+
+		body, _ := ioutil.ReadAll(w.Body)
+		if w.Code != tc.code {
+			t.Errorf("%q: got status %d, want %d", tc.query, w.Code, tc.code)
+		}
+		if !strings.Contains(string(body), tc.body) {
+			t.Errorf("%q: body doesn't contain %q:\n%s", tc.query, tc.body, body)
+		}
+	}
+}
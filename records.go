@@ -0,0 +1,784 @@
+package main
+
+// atsRecords are the numeric records ATS 7.1 reports in its stats, as in
+// test/trafficserver.json. Their descriptors are built at startup, so that
+// the dynamic profile is described up front too. Records missing from this
+// list, e.g. from plugins or newer ATS versions, are described on first
+// sight, see descCache.
+var atsRecords = []string{
+	"proxy.node.bandwidth_hit_ratio",
+	"proxy.node.bandwidth_hit_ratio_avg_10s",
+	"proxy.node.cache.bytes_free",
+	"proxy.node.cache.bytes_free_mb",
+	"proxy.node.cache.bytes_total",
+	"proxy.node.cache.bytes_total_mb",
+	"proxy.node.cache.percent_free",
+	"proxy.node.cache_hit_mem_ratio",
+	"proxy.node.cache_hit_mem_ratio_avg_10s",
+	"proxy.node.cache_hit_ratio",
+	"proxy.node.cache_hit_ratio_avg_10s",
+	"proxy.node.cache_total_hits",
+	"proxy.node.cache_total_hits_avg_10s",
+	"proxy.node.cache_total_hits_mem",
+	"proxy.node.cache_total_hits_mem_avg_10s",
+	"proxy.node.cache_total_misses",
+	"proxy.node.cache_total_misses_avg_10s",
+	"proxy.node.client_throughput_out",
+	"proxy.node.client_throughput_out_kbit",
+	"proxy.node.cluster.nodes",
+	"proxy.node.config.reconfigure_required",
+	"proxy.node.config.reconfigure_time",
+	"proxy.node.config.restart_required.cop",
+	"proxy.node.config.restart_required.manager",
+	"proxy.node.config.restart_required.proxy",
+	"proxy.node.current_cache_connections",
+	"proxy.node.current_client_connections",
+	"proxy.node.current_server_connections",
+	"proxy.node.dns.lookups_per_second",
+	"proxy.node.dns.total_dns_lookups",
+	"proxy.node.hostdb.hit_ratio",
+	"proxy.node.hostdb.hit_ratio_avg_10s",
+	"proxy.node.hostdb.total_hits",
+	"proxy.node.hostdb.total_hits_avg_10s",
+	"proxy.node.hostdb.total_lookups",
+	"proxy.node.hostdb.total_lookups_avg_10s",
+	"proxy.node.http.cache_current_connections_count",
+	"proxy.node.http.cache_deletes",
+	"proxy.node.http.cache_hit_fresh",
+	"proxy.node.http.cache_hit_fresh_avg_10s",
+	"proxy.node.http.cache_hit_ims",
+	"proxy.node.http.cache_hit_ims_avg_10s",
+	"proxy.node.http.cache_hit_mem_fresh",
+	"proxy.node.http.cache_hit_mem_fresh_avg_10s",
+	"proxy.node.http.cache_hit_revalidated",
+	"proxy.node.http.cache_hit_revalidated_avg_10s",
+	"proxy.node.http.cache_hit_stale_served",
+	"proxy.node.http.cache_hit_stale_served_avg_10s",
+	"proxy.node.http.cache_lookups",
+	"proxy.node.http.cache_miss_changed",
+	"proxy.node.http.cache_miss_changed_avg_10s",
+	"proxy.node.http.cache_miss_client_no_cache",
+	"proxy.node.http.cache_miss_client_no_cache_avg_10s",
+	"proxy.node.http.cache_miss_client_not_cacheable",
+	"proxy.node.http.cache_miss_cold",
+	"proxy.node.http.cache_miss_cold_avg_10s",
+	"proxy.node.http.cache_miss_ims",
+	"proxy.node.http.cache_miss_ims_avg_10s",
+	"proxy.node.http.cache_read_error",
+	"proxy.node.http.cache_read_error_avg_10s",
+	"proxy.node.http.cache_read_errors",
+	"proxy.node.http.cache_updates",
+	"proxy.node.http.cache_write_errors",
+	"proxy.node.http.cache_writes",
+	"proxy.node.http.current_parent_proxy_connections",
+	"proxy.node.http.origin_server_current_connections_count",
+	"proxy.node.http.origin_server_total_request_bytes",
+	"proxy.node.http.origin_server_total_response_bytes",
+	"proxy.node.http.origin_server_total_transactions_count",
+	"proxy.node.http.parent_proxy_total_request_bytes",
+	"proxy.node.http.parent_proxy_total_response_bytes",
+	"proxy.node.http.throughput",
+	"proxy.node.http.transaction_counts_avg_10s.errors.aborts",
+	"proxy.node.http.transaction_counts_avg_10s.errors.connect_failed",
+	"proxy.node.http.transaction_counts_avg_10s.errors.other",
+	"proxy.node.http.transaction_counts_avg_10s.errors.possible_aborts",
+	"proxy.node.http.transaction_counts_avg_10s.errors.pre_accept_hangups",
+	"proxy.node.http.transaction_counts_avg_10s.hit_fresh",
+	"proxy.node.http.transaction_counts_avg_10s.hit_revalidated",
+	"proxy.node.http.transaction_counts_avg_10s.miss_changed",
+	"proxy.node.http.transaction_counts_avg_10s.miss_client_no_cache",
+	"proxy.node.http.transaction_counts_avg_10s.miss_cold",
+	"proxy.node.http.transaction_counts_avg_10s.miss_not_cacheable",
+	"proxy.node.http.transaction_frac_avg_10s.errors.aborts",
+	"proxy.node.http.transaction_frac_avg_10s.errors.connect_failed",
+	"proxy.node.http.transaction_frac_avg_10s.errors.other",
+	"proxy.node.http.transaction_frac_avg_10s.errors.possible_aborts",
+	"proxy.node.http.transaction_frac_avg_10s.errors.pre_accept_hangups",
+	"proxy.node.http.transaction_frac_avg_10s.hit_fresh",
+	"proxy.node.http.transaction_frac_avg_10s.hit_revalidated",
+	"proxy.node.http.transaction_frac_avg_10s.miss_changed",
+	"proxy.node.http.transaction_frac_avg_10s.miss_client_no_cache",
+	"proxy.node.http.transaction_frac_avg_10s.miss_cold",
+	"proxy.node.http.transaction_frac_avg_10s.miss_not_cacheable",
+	"proxy.node.http.transaction_msec_avg_10s.errors.aborts",
+	"proxy.node.http.transaction_msec_avg_10s.errors.connect_failed",
+	"proxy.node.http.transaction_msec_avg_10s.errors.other",
+	"proxy.node.http.transaction_msec_avg_10s.errors.possible_aborts",
+	"proxy.node.http.transaction_msec_avg_10s.errors.pre_accept_hangups",
+	"proxy.node.http.transaction_msec_avg_10s.hit_fresh",
+	"proxy.node.http.transaction_msec_avg_10s.hit_revalidated",
+	"proxy.node.http.transaction_msec_avg_10s.miss_changed",
+	"proxy.node.http.transaction_msec_avg_10s.miss_client_no_cache",
+	"proxy.node.http.transaction_msec_avg_10s.miss_cold",
+	"proxy.node.http.transaction_msec_avg_10s.miss_not_cacheable",
+	"proxy.node.http.user_agent_current_connections_count",
+	"proxy.node.http.user_agent_total_request_bytes",
+	"proxy.node.http.user_agent_total_response_bytes",
+	"proxy.node.http.user_agent_xacts_per_second",
+	"proxy.node.http.user_agents_total_documents_served",
+	"proxy.node.http.user_agents_total_transactions_count",
+	"proxy.node.log.bytes_lost_before_flush_to_disk",
+	"proxy.node.log.bytes_lost_before_preproc",
+	"proxy.node.log.bytes_lost_before_sent_to_network",
+	"proxy.node.log.bytes_lost_before_written_to_disk",
+	"proxy.node.log.bytes_received_from_network",
+	"proxy.node.log.bytes_received_from_network_avg_10s",
+	"proxy.node.log.bytes_sent_to_network",
+	"proxy.node.log.bytes_sent_to_network_avg_10s",
+	"proxy.node.log.event_log_access_aggr",
+	"proxy.node.log.event_log_access_fail",
+	"proxy.node.log.event_log_access_full",
+	"proxy.node.log.event_log_access_ok",
+	"proxy.node.log.event_log_access_skip",
+	"proxy.node.log.num_lost_before_flush_to_disk",
+	"proxy.node.log.num_lost_before_sent_to_network",
+	"proxy.node.log.num_received_from_network",
+	"proxy.node.log.num_sent_to_network",
+	"proxy.node.origin_server_total_bytes",
+	"proxy.node.origin_server_total_bytes_avg_10s",
+	"proxy.node.proxy_running",
+	"proxy.node.restarts.manager.start_time",
+	"proxy.node.restarts.proxy.cache_ready_time",
+	"proxy.node.restarts.proxy.restart_count",
+	"proxy.node.restarts.proxy.start_time",
+	"proxy.node.restarts.proxy.stop_time",
+	"proxy.node.user_agent_total_bytes",
+	"proxy.node.user_agent_total_bytes_avg_10s",
+	"proxy.node.user_agent_xacts_per_second",
+	"proxy.node.user_agents_total_documents_served",
+	"proxy.process.cache.KB_read_per_sec",
+	"proxy.process.cache.KB_write_per_sec",
+	"proxy.process.cache.bytes_total",
+	"proxy.process.cache.bytes_used",
+	"proxy.process.cache.directory_collision",
+	"proxy.process.cache.direntries.total",
+	"proxy.process.cache.direntries.used",
+	"proxy.process.cache.evacuate.active",
+	"proxy.process.cache.evacuate.failure",
+	"proxy.process.cache.evacuate.success",
+	"proxy.process.cache.frags_per_doc.1",
+	"proxy.process.cache.frags_per_doc.2",
+	"proxy.process.cache.frags_per_doc.3+",
+	"proxy.process.cache.gc_bytes_evacuated",
+	"proxy.process.cache.gc_frags_evacuated",
+	"proxy.process.cache.hdr_marshal_bytes",
+	"proxy.process.cache.hdr_marshals",
+	"proxy.process.cache.lookup.active",
+	"proxy.process.cache.lookup.failure",
+	"proxy.process.cache.lookup.success",
+	"proxy.process.cache.percent_full",
+	"proxy.process.cache.pread_count",
+	"proxy.process.cache.ram_cache.bytes_used",
+	"proxy.process.cache.ram_cache.hits",
+	"proxy.process.cache.ram_cache.misses",
+	"proxy.process.cache.ram_cache.total_bytes",
+	"proxy.process.cache.read.active",
+	"proxy.process.cache.read.failure",
+	"proxy.process.cache.read.success",
+	"proxy.process.cache.read_busy.failure",
+	"proxy.process.cache.read_busy.success",
+	"proxy.process.cache.read_per_sec",
+	"proxy.process.cache.remove.active",
+	"proxy.process.cache.remove.failure",
+	"proxy.process.cache.remove.success",
+	"proxy.process.cache.scan.active",
+	"proxy.process.cache.scan.failure",
+	"proxy.process.cache.scan.success",
+	"proxy.process.cache.span.errors.read",
+	"proxy.process.cache.span.errors.write",
+	"proxy.process.cache.span.failing",
+	"proxy.process.cache.span.offline",
+	"proxy.process.cache.span.online",
+	"proxy.process.cache.sync.bytes",
+	"proxy.process.cache.sync.count",
+	"proxy.process.cache.sync.time",
+	"proxy.process.cache.update.active",
+	"proxy.process.cache.update.failure",
+	"proxy.process.cache.update.success",
+	"proxy.process.cache.vector_marshals",
+	"proxy.process.cache.volume_0.bytes_total",
+	"proxy.process.cache.volume_0.bytes_used",
+	"proxy.process.cache.volume_0.directory_collision",
+	"proxy.process.cache.volume_0.direntries.total",
+	"proxy.process.cache.volume_0.direntries.used",
+	"proxy.process.cache.volume_0.evacuate.active",
+	"proxy.process.cache.volume_0.evacuate.failure",
+	"proxy.process.cache.volume_0.evacuate.success",
+	"proxy.process.cache.volume_0.frags_per_doc.1",
+	"proxy.process.cache.volume_0.frags_per_doc.2",
+	"proxy.process.cache.volume_0.frags_per_doc.3+",
+	"proxy.process.cache.volume_0.gc_bytes_evacuated",
+	"proxy.process.cache.volume_0.gc_frags_evacuated",
+	"proxy.process.cache.volume_0.hdr_marshal_bytes",
+	"proxy.process.cache.volume_0.hdr_marshals",
+	"proxy.process.cache.volume_0.lookup.active",
+	"proxy.process.cache.volume_0.lookup.failure",
+	"proxy.process.cache.volume_0.lookup.success",
+	"proxy.process.cache.volume_0.percent_full",
+	"proxy.process.cache.volume_0.pread_count",
+	"proxy.process.cache.volume_0.ram_cache.bytes_used",
+	"proxy.process.cache.volume_0.ram_cache.hits",
+	"proxy.process.cache.volume_0.ram_cache.misses",
+	"proxy.process.cache.volume_0.ram_cache.total_bytes",
+	"proxy.process.cache.volume_0.read.active",
+	"proxy.process.cache.volume_0.read.failure",
+	"proxy.process.cache.volume_0.read.success",
+	"proxy.process.cache.volume_0.read_busy.failure",
+	"proxy.process.cache.volume_0.read_busy.success",
+	"proxy.process.cache.volume_0.remove.active",
+	"proxy.process.cache.volume_0.remove.failure",
+	"proxy.process.cache.volume_0.remove.success",
+	"proxy.process.cache.volume_0.scan.active",
+	"proxy.process.cache.volume_0.scan.failure",
+	"proxy.process.cache.volume_0.scan.success",
+	"proxy.process.cache.volume_0.span.errors.read",
+	"proxy.process.cache.volume_0.span.errors.write",
+	"proxy.process.cache.volume_0.span.failing",
+	"proxy.process.cache.volume_0.span.offline",
+	"proxy.process.cache.volume_0.span.online",
+	"proxy.process.cache.volume_0.sync.bytes",
+	"proxy.process.cache.volume_0.sync.count",
+	"proxy.process.cache.volume_0.sync.time",
+	"proxy.process.cache.volume_0.update.active",
+	"proxy.process.cache.volume_0.update.failure",
+	"proxy.process.cache.volume_0.update.success",
+	"proxy.process.cache.volume_0.vector_marshals",
+	"proxy.process.cache.volume_0.wrap_count",
+	"proxy.process.cache.volume_0.write.active",
+	"proxy.process.cache.volume_0.write.backlog.failure",
+	"proxy.process.cache.volume_0.write.failure",
+	"proxy.process.cache.volume_0.write.success",
+	"proxy.process.cache.volume_0.write_bytes_stat",
+	"proxy.process.cache.wrap_count",
+	"proxy.process.cache.write.active",
+	"proxy.process.cache.write.backlog.failure",
+	"proxy.process.cache.write.failure",
+	"proxy.process.cache.write.success",
+	"proxy.process.cache.write_bytes_stat",
+	"proxy.process.cache.write_per_sec",
+	"proxy.process.cluster.alloc_data_news",
+	"proxy.process.cluster.byte_bank_used",
+	"proxy.process.cluster.cache_callback_time",
+	"proxy.process.cluster.cache_callbacks",
+	"proxy.process.cluster.cache_outstanding",
+	"proxy.process.cluster.chan_inuse",
+	"proxy.process.cluster.cluster_ping_time",
+	"proxy.process.cluster.configuration_changes",
+	"proxy.process.cluster.connections_avg_time",
+	"proxy.process.cluster.connections_bumped",
+	"proxy.process.cluster.connections_closed",
+	"proxy.process.cluster.connections_open",
+	"proxy.process.cluster.connections_opened",
+	"proxy.process.cluster.connections_read_locked",
+	"proxy.process.cluster.connections_write_locked",
+	"proxy.process.cluster.control_messages_avg_receive_time",
+	"proxy.process.cluster.control_messages_avg_send_time",
+	"proxy.process.cluster.control_messages_received",
+	"proxy.process.cluster.control_messages_sent",
+	"proxy.process.cluster.delayed_reads",
+	"proxy.process.cluster.level1_bank",
+	"proxy.process.cluster.lkrmt_cache_callback_time",
+	"proxy.process.cluster.lkrmt_cache_callbacks",
+	"proxy.process.cluster.local_connection_time",
+	"proxy.process.cluster.local_connections_closed",
+	"proxy.process.cluster.machines_allocated",
+	"proxy.process.cluster.machines_freed",
+	"proxy.process.cluster.multilevel_bank",
+	"proxy.process.cluster.net_backup",
+	"proxy.process.cluster.no_remote_space",
+	"proxy.process.cluster.nodes",
+	"proxy.process.cluster.op_delayed_for_lock",
+	"proxy.process.cluster.open_delay_time",
+	"proxy.process.cluster.open_delays",
+	"proxy.process.cluster.partial_reads",
+	"proxy.process.cluster.partial_writes",
+	"proxy.process.cluster.rdmsg_assemble_time",
+	"proxy.process.cluster.read_bytes",
+	"proxy.process.cluster.reads",
+	"proxy.process.cluster.remote_connection_time",
+	"proxy.process.cluster.remote_connections_closed",
+	"proxy.process.cluster.remote_op_reply_timeouts",
+	"proxy.process.cluster.remote_op_timeouts",
+	"proxy.process.cluster.rmt_cache_callback_time",
+	"proxy.process.cluster.rmt_cache_callbacks",
+	"proxy.process.cluster.setdata_no_cachevc",
+	"proxy.process.cluster.setdata_no_cluster",
+	"proxy.process.cluster.setdata_no_clustervc",
+	"proxy.process.cluster.setdata_no_tunnel",
+	"proxy.process.cluster.slow_ctrl_msgs_sent",
+	"proxy.process.cluster.vc_cache_insert_lock_misses",
+	"proxy.process.cluster.vc_cache_inserts",
+	"proxy.process.cluster.vc_cache_lookup_hits",
+	"proxy.process.cluster.vc_cache_lookup_lock_misses",
+	"proxy.process.cluster.vc_cache_lookup_misses",
+	"proxy.process.cluster.vc_cache_purges",
+	"proxy.process.cluster.vc_cache_scan_lock_misses",
+	"proxy.process.cluster.vc_cache_scans",
+	"proxy.process.cluster.vc_read_list_len",
+	"proxy.process.cluster.vc_write_list_len",
+	"proxy.process.cluster.vc_write_stall",
+	"proxy.process.cluster.write_bb_mallocs",
+	"proxy.process.cluster.write_bytes",
+	"proxy.process.cluster.write_lock_misses",
+	"proxy.process.cluster.writes",
+	"proxy.process.congestion.congested_on_conn_failures",
+	"proxy.process.congestion.congested_on_max_connection",
+	"proxy.process.dns.fail_avg_time",
+	"proxy.process.dns.in_flight",
+	"proxy.process.dns.lookup_avg_time",
+	"proxy.process.dns.lookup_failures",
+	"proxy.process.dns.lookup_successes",
+	"proxy.process.dns.max_retries_exceeded",
+	"proxy.process.dns.retries",
+	"proxy.process.dns.success_avg_time",
+	"proxy.process.dns.total_dns_lookups",
+	"proxy.process.hostdb.cache.current_items",
+	"proxy.process.hostdb.cache.current_size",
+	"proxy.process.hostdb.cache.last_sync.time",
+	"proxy.process.hostdb.cache.last_sync.total_items",
+	"proxy.process.hostdb.cache.last_sync.total_size",
+	"proxy.process.hostdb.cache.total_failed_inserts",
+	"proxy.process.hostdb.cache.total_hits",
+	"proxy.process.hostdb.cache.total_inserts",
+	"proxy.process.hostdb.cache.total_lookups",
+	"proxy.process.hostdb.re_dns_on_reload",
+	"proxy.process.hostdb.total_hits",
+	"proxy.process.hostdb.total_lookups",
+	"proxy.process.hostdb.ttl",
+	"proxy.process.hostdb.ttl_expires",
+	"proxy.process.http.100_responses",
+	"proxy.process.http.101_responses",
+	"proxy.process.http.1xx_responses",
+	"proxy.process.http.200_responses",
+	"proxy.process.http.201_responses",
+	"proxy.process.http.202_responses",
+	"proxy.process.http.203_responses",
+	"proxy.process.http.204_responses",
+	"proxy.process.http.205_responses",
+	"proxy.process.http.206_responses",
+	"proxy.process.http.2xx_responses",
+	"proxy.process.http.300_responses",
+	"proxy.process.http.301_responses",
+	"proxy.process.http.302_responses",
+	"proxy.process.http.303_responses",
+	"proxy.process.http.304_responses",
+	"proxy.process.http.305_responses",
+	"proxy.process.http.307_responses",
+	"proxy.process.http.3xx_responses",
+	"proxy.process.http.400_responses",
+	"proxy.process.http.401_responses",
+	"proxy.process.http.402_responses",
+	"proxy.process.http.403_responses",
+	"proxy.process.http.404_responses",
+	"proxy.process.http.405_responses",
+	"proxy.process.http.406_responses",
+	"proxy.process.http.407_responses",
+	"proxy.process.http.408_responses",
+	"proxy.process.http.409_responses",
+	"proxy.process.http.410_responses",
+	"proxy.process.http.411_responses",
+	"proxy.process.http.412_responses",
+	"proxy.process.http.413_responses",
+	"proxy.process.http.414_responses",
+	"proxy.process.http.415_responses",
+	"proxy.process.http.416_responses",
+	"proxy.process.http.4xx_responses",
+	"proxy.process.http.500_responses",
+	"proxy.process.http.501_responses",
+	"proxy.process.http.502_responses",
+	"proxy.process.http.503_responses",
+	"proxy.process.http.504_responses",
+	"proxy.process.http.505_responses",
+	"proxy.process.http.5xx_responses",
+	"proxy.process.http.avg_transactions_per_client_connection",
+	"proxy.process.http.avg_transactions_per_server_connection",
+	"proxy.process.http.background_fill_bytes_aborted_stat",
+	"proxy.process.http.background_fill_bytes_completed_stat",
+	"proxy.process.http.background_fill_current_count",
+	"proxy.process.http.broken_server_connections",
+	"proxy.process.http.cache_deletes",
+	"proxy.process.http.cache_hit_fresh",
+	"proxy.process.http.cache_hit_ims",
+	"proxy.process.http.cache_hit_mem_fresh",
+	"proxy.process.http.cache_hit_revalidated",
+	"proxy.process.http.cache_hit_stale_served",
+	"proxy.process.http.cache_lookups",
+	"proxy.process.http.cache_miss_changed",
+	"proxy.process.http.cache_miss_client_no_cache",
+	"proxy.process.http.cache_miss_client_not_cacheable",
+	"proxy.process.http.cache_miss_cold",
+	"proxy.process.http.cache_miss_ims",
+	"proxy.process.http.cache_read_error",
+	"proxy.process.http.cache_read_errors",
+	"proxy.process.http.cache_updates",
+	"proxy.process.http.cache_write_errors",
+	"proxy.process.http.cache_writes",
+	"proxy.process.http.completed_requests",
+	"proxy.process.http.connect_requests",
+	"proxy.process.http.current_active_client_connections",
+	"proxy.process.http.current_cache_connections",
+	"proxy.process.http.current_client_connections",
+	"proxy.process.http.current_client_transactions",
+	"proxy.process.http.current_parent_proxy_connections",
+	"proxy.process.http.current_server_connections",
+	"proxy.process.http.current_server_transactions",
+	"proxy.process.http.delete_requests",
+	"proxy.process.http.disallowed_post_100_continue",
+	"proxy.process.http.err_client_abort_count_stat",
+	"proxy.process.http.err_client_abort_origin_server_bytes_stat",
+	"proxy.process.http.err_client_abort_user_agent_bytes_stat",
+	"proxy.process.http.err_connect_fail_count_stat",
+	"proxy.process.http.err_connect_fail_origin_server_bytes_stat",
+	"proxy.process.http.err_connect_fail_user_agent_bytes_stat",
+	"proxy.process.http.extension_method_requests",
+	"proxy.process.http.get_requests",
+	"proxy.process.http.head_requests",
+	"proxy.process.http.http_misc_origin_server_bytes_stat",
+	"proxy.process.http.incoming_requests",
+	"proxy.process.http.incoming_responses",
+	"proxy.process.http.invalid_client_requests",
+	"proxy.process.http.milestone.cache_open_read_begin",
+	"proxy.process.http.milestone.cache_open_read_end",
+	"proxy.process.http.milestone.cache_open_write_begin",
+	"proxy.process.http.milestone.cache_open_write_end",
+	"proxy.process.http.milestone.dns_lookup_begin",
+	"proxy.process.http.milestone.dns_lookup_end",
+	"proxy.process.http.milestone.server_begin_write",
+	"proxy.process.http.milestone.server_close",
+	"proxy.process.http.milestone.server_connect",
+	"proxy.process.http.milestone.server_connect_end",
+	"proxy.process.http.milestone.server_first_connect",
+	"proxy.process.http.milestone.server_first_read",
+	"proxy.process.http.milestone.server_read_header_done",
+	"proxy.process.http.milestone.sm_finish",
+	"proxy.process.http.milestone.sm_start",
+	"proxy.process.http.milestone.ua_begin",
+	"proxy.process.http.milestone.ua_begin_write",
+	"proxy.process.http.milestone.ua_close",
+	"proxy.process.http.milestone.ua_first_read",
+	"proxy.process.http.milestone.ua_read_header_done",
+	"proxy.process.http.misc_count_stat",
+	"proxy.process.http.misc_user_agent_bytes_stat",
+	"proxy.process.http.missing_host_hdr",
+	"proxy.process.http.options_requests",
+	"proxy.process.http.origin_connections_throttled_out",
+	"proxy.process.http.origin_server_request_document_total_size",
+	"proxy.process.http.origin_server_request_header_total_size",
+	"proxy.process.http.origin_server_response_document_total_size",
+	"proxy.process.http.origin_server_response_header_total_size",
+	"proxy.process.http.origin_server_speed_bytes_per_sec_100",
+	"proxy.process.http.origin_server_speed_bytes_per_sec_100K",
+	"proxy.process.http.origin_server_speed_bytes_per_sec_100M",
+	"proxy.process.http.origin_server_speed_bytes_per_sec_10K",
+	"proxy.process.http.origin_server_speed_bytes_per_sec_10M",
+	"proxy.process.http.origin_server_speed_bytes_per_sec_1K",
+	"proxy.process.http.origin_server_speed_bytes_per_sec_1M",
+	"proxy.process.http.outgoing_requests",
+	"proxy.process.http.parent_proxy_request_total_bytes",
+	"proxy.process.http.parent_proxy_response_total_bytes",
+	"proxy.process.http.parent_proxy_transaction_time",
+	"proxy.process.http.post_body_too_large",
+	"proxy.process.http.post_requests",
+	"proxy.process.http.purge_requests",
+	"proxy.process.http.push_requests",
+	"proxy.process.http.pushed_document_total_size",
+	"proxy.process.http.pushed_response_header_total_size",
+	"proxy.process.http.put_requests",
+	"proxy.process.http.request_document_size_100",
+	"proxy.process.http.request_document_size_10K",
+	"proxy.process.http.request_document_size_1K",
+	"proxy.process.http.request_document_size_1M",
+	"proxy.process.http.request_document_size_3K",
+	"proxy.process.http.request_document_size_5K",
+	"proxy.process.http.request_document_size_inf",
+	"proxy.process.http.response_document_size_100",
+	"proxy.process.http.response_document_size_10K",
+	"proxy.process.http.response_document_size_1K",
+	"proxy.process.http.response_document_size_1M",
+	"proxy.process.http.response_document_size_3K",
+	"proxy.process.http.response_document_size_5K",
+	"proxy.process.http.response_document_size_inf",
+	"proxy.process.http.tcp_client_refresh_count_stat",
+	"proxy.process.http.tcp_client_refresh_origin_server_bytes_stat",
+	"proxy.process.http.tcp_client_refresh_user_agent_bytes_stat",
+	"proxy.process.http.tcp_expired_miss_count_stat",
+	"proxy.process.http.tcp_expired_miss_origin_server_bytes_stat",
+	"proxy.process.http.tcp_expired_miss_user_agent_bytes_stat",
+	"proxy.process.http.tcp_hit_count_stat",
+	"proxy.process.http.tcp_hit_origin_server_bytes_stat",
+	"proxy.process.http.tcp_hit_user_agent_bytes_stat",
+	"proxy.process.http.tcp_ims_hit_count_stat",
+	"proxy.process.http.tcp_ims_hit_origin_server_bytes_stat",
+	"proxy.process.http.tcp_ims_hit_user_agent_bytes_stat",
+	"proxy.process.http.tcp_ims_miss_count_stat",
+	"proxy.process.http.tcp_ims_miss_origin_server_bytes_stat",
+	"proxy.process.http.tcp_ims_miss_user_agent_bytes_stat",
+	"proxy.process.http.tcp_miss_count_stat",
+	"proxy.process.http.tcp_miss_origin_server_bytes_stat",
+	"proxy.process.http.tcp_miss_user_agent_bytes_stat",
+	"proxy.process.http.tcp_refresh_hit_count_stat",
+	"proxy.process.http.tcp_refresh_hit_origin_server_bytes_stat",
+	"proxy.process.http.tcp_refresh_hit_user_agent_bytes_stat",
+	"proxy.process.http.tcp_refresh_miss_count_stat",
+	"proxy.process.http.tcp_refresh_miss_origin_server_bytes_stat",
+	"proxy.process.http.tcp_refresh_miss_user_agent_bytes_stat",
+	"proxy.process.http.throttled_proxy_only",
+	"proxy.process.http.total_client_connections",
+	"proxy.process.http.total_client_connections_ipv4",
+	"proxy.process.http.total_client_connections_ipv6",
+	"proxy.process.http.total_incoming_connections",
+	"proxy.process.http.total_parent_marked_down_count",
+	"proxy.process.http.total_parent_proxy_connections",
+	"proxy.process.http.total_parent_retries",
+	"proxy.process.http.total_parent_retries_exhausted",
+	"proxy.process.http.total_parent_switches",
+	"proxy.process.http.total_server_connections",
+	"proxy.process.http.total_transactions_time",
+	"proxy.process.http.total_x_redirect_count",
+	"proxy.process.http.trace_requests",
+	"proxy.process.http.transaction_counts.errors.aborts",
+	"proxy.process.http.transaction_counts.errors.connect_failed",
+	"proxy.process.http.transaction_counts.errors.other",
+	"proxy.process.http.transaction_counts.errors.possible_aborts",
+	"proxy.process.http.transaction_counts.errors.pre_accept_hangups",
+	"proxy.process.http.transaction_counts.hit_fresh",
+	"proxy.process.http.transaction_counts.hit_fresh.process",
+	"proxy.process.http.transaction_counts.hit_revalidated",
+	"proxy.process.http.transaction_counts.miss_changed",
+	"proxy.process.http.transaction_counts.miss_client_no_cache",
+	"proxy.process.http.transaction_counts.miss_cold",
+	"proxy.process.http.transaction_counts.miss_not_cacheable",
+	"proxy.process.http.transaction_counts.other.unclassified",
+	"proxy.process.http.transaction_totaltime.errors.aborts",
+	"proxy.process.http.transaction_totaltime.errors.connect_failed",
+	"proxy.process.http.transaction_totaltime.errors.other",
+	"proxy.process.http.transaction_totaltime.errors.possible_aborts",
+	"proxy.process.http.transaction_totaltime.errors.pre_accept_hangups",
+	"proxy.process.http.transaction_totaltime.hit_fresh",
+	"proxy.process.http.transaction_totaltime.hit_fresh.process",
+	"proxy.process.http.transaction_totaltime.hit_revalidated",
+	"proxy.process.http.transaction_totaltime.miss_changed",
+	"proxy.process.http.transaction_totaltime.miss_client_no_cache",
+	"proxy.process.http.transaction_totaltime.miss_cold",
+	"proxy.process.http.transaction_totaltime.miss_not_cacheable",
+	"proxy.process.http.transaction_totaltime.other.unclassified",
+	"proxy.process.http.tunnels",
+	"proxy.process.http.user_agent_request_document_total_size",
+	"proxy.process.http.user_agent_request_header_total_size",
+	"proxy.process.http.user_agent_response_document_total_size",
+	"proxy.process.http.user_agent_response_header_total_size",
+	"proxy.process.http.user_agent_speed_bytes_per_sec_100",
+	"proxy.process.http.user_agent_speed_bytes_per_sec_100K",
+	"proxy.process.http.user_agent_speed_bytes_per_sec_100M",
+	"proxy.process.http.user_agent_speed_bytes_per_sec_10K",
+	"proxy.process.http.user_agent_speed_bytes_per_sec_10M",
+	"proxy.process.http.user_agent_speed_bytes_per_sec_1K",
+	"proxy.process.http.user_agent_speed_bytes_per_sec_1M",
+	"proxy.process.http.websocket.current_active_client_connections",
+	"proxy.process.http2.connection_errors",
+	"proxy.process.http2.current_client_sessions",
+	"proxy.process.http2.current_client_streams",
+	"proxy.process.http2.session_die_active",
+	"proxy.process.http2.session_die_default",
+	"proxy.process.http2.session_die_eos",
+	"proxy.process.http2.session_die_error",
+	"proxy.process.http2.session_die_inactive",
+	"proxy.process.http2.session_die_other",
+	"proxy.process.http2.stream_errors",
+	"proxy.process.http2.total_client_connections",
+	"proxy.process.http2.total_client_streams",
+	"proxy.process.http2.total_transactions_time",
+	"proxy.process.https.incoming_requests",
+	"proxy.process.https.total_client_connections",
+	"proxy.process.log.bytes_flush_to_disk",
+	"proxy.process.log.bytes_lost_before_flush_to_disk",
+	"proxy.process.log.bytes_lost_before_preproc",
+	"proxy.process.log.bytes_lost_before_sent_to_network",
+	"proxy.process.log.bytes_lost_before_written_to_disk",
+	"proxy.process.log.bytes_received_from_network",
+	"proxy.process.log.bytes_sent_to_network",
+	"proxy.process.log.bytes_written_to_disk",
+	"proxy.process.log.event_log_access_aggr",
+	"proxy.process.log.event_log_access_fail",
+	"proxy.process.log.event_log_access_full",
+	"proxy.process.log.event_log_access_ok",
+	"proxy.process.log.event_log_access_skip",
+	"proxy.process.log.event_log_error_aggr",
+	"proxy.process.log.event_log_error_fail",
+	"proxy.process.log.event_log_error_full",
+	"proxy.process.log.event_log_error_ok",
+	"proxy.process.log.event_log_error_skip",
+	"proxy.process.log.log_files_open",
+	"proxy.process.log.log_files_space_used",
+	"proxy.process.log.num_flush_to_disk",
+	"proxy.process.log.num_lost_before_flush_to_disk",
+	"proxy.process.log.num_lost_before_sent_to_network",
+	"proxy.process.log.num_received_from_network",
+	"proxy.process.log.num_sent_to_network",
+	"proxy.process.net.accepts_currently_open",
+	"proxy.process.net.calls_to_read",
+	"proxy.process.net.calls_to_read_nodata",
+	"proxy.process.net.calls_to_readfromnet",
+	"proxy.process.net.calls_to_readfromnet_afterpoll",
+	"proxy.process.net.calls_to_write",
+	"proxy.process.net.calls_to_write_nodata",
+	"proxy.process.net.calls_to_writetonet",
+	"proxy.process.net.calls_to_writetonet_afterpoll",
+	"proxy.process.net.connections_currently_open",
+	"proxy.process.net.default_inactivity_timeout_applied",
+	"proxy.process.net.dynamic_keep_alive_timeout_in_count",
+	"proxy.process.net.dynamic_keep_alive_timeout_in_total",
+	"proxy.process.net.fastopen_out.attempts",
+	"proxy.process.net.fastopen_out.successes",
+	"proxy.process.net.inactivity_cop_lock_acquire_failure",
+	"proxy.process.net.net_handler_run",
+	"proxy.process.net.read_bytes",
+	"proxy.process.net.write_bytes",
+	"proxy.process.socks.connections_currently_open",
+	"proxy.process.socks.connections_successful",
+	"proxy.process.socks.connections_unsuccessful",
+	"proxy.process.ssl.cipher.user_agent.AES128-GCM-SHA256",
+	"proxy.process.ssl.cipher.user_agent.AES128-SHA",
+	"proxy.process.ssl.cipher.user_agent.AES128-SHA256",
+	"proxy.process.ssl.cipher.user_agent.AES256-GCM-SHA384",
+	"proxy.process.ssl.cipher.user_agent.AES256-SHA",
+	"proxy.process.ssl.cipher.user_agent.AES256-SHA256",
+	"proxy.process.ssl.cipher.user_agent.CAMELLIA128-SHA",
+	"proxy.process.ssl.cipher.user_agent.CAMELLIA256-SHA",
+	"proxy.process.ssl.cipher.user_agent.DES-CBC3-SHA",
+	"proxy.process.ssl.cipher.user_agent.DH-DSS-AES128-GCM-SHA256",
+	"proxy.process.ssl.cipher.user_agent.DH-DSS-AES128-SHA",
+	"proxy.process.ssl.cipher.user_agent.DH-DSS-AES128-SHA256",
+	"proxy.process.ssl.cipher.user_agent.DH-DSS-AES256-GCM-SHA384",
+	"proxy.process.ssl.cipher.user_agent.DH-DSS-AES256-SHA",
+	"proxy.process.ssl.cipher.user_agent.DH-DSS-AES256-SHA256",
+	"proxy.process.ssl.cipher.user_agent.DH-DSS-CAMELLIA128-SHA",
+	"proxy.process.ssl.cipher.user_agent.DH-DSS-CAMELLIA256-SHA",
+	"proxy.process.ssl.cipher.user_agent.DH-DSS-DES-CBC3-SHA",
+	"proxy.process.ssl.cipher.user_agent.DH-DSS-SEED-SHA",
+	"proxy.process.ssl.cipher.user_agent.DH-RSA-AES128-GCM-SHA256",
+	"proxy.process.ssl.cipher.user_agent.DH-RSA-AES128-SHA",
+	"proxy.process.ssl.cipher.user_agent.DH-RSA-AES128-SHA256",
+	"proxy.process.ssl.cipher.user_agent.DH-RSA-AES256-GCM-SHA384",
+	"proxy.process.ssl.cipher.user_agent.DH-RSA-AES256-SHA",
+	"proxy.process.ssl.cipher.user_agent.DH-RSA-AES256-SHA256",
+	"proxy.process.ssl.cipher.user_agent.DH-RSA-CAMELLIA128-SHA",
+	"proxy.process.ssl.cipher.user_agent.DH-RSA-CAMELLIA256-SHA",
+	"proxy.process.ssl.cipher.user_agent.DH-RSA-DES-CBC3-SHA",
+	"proxy.process.ssl.cipher.user_agent.DH-RSA-SEED-SHA",
+	"proxy.process.ssl.cipher.user_agent.DHE-DSS-AES128-GCM-SHA256",
+	"proxy.process.ssl.cipher.user_agent.DHE-DSS-AES128-SHA",
+	"proxy.process.ssl.cipher.user_agent.DHE-DSS-AES128-SHA256",
+	"proxy.process.ssl.cipher.user_agent.DHE-DSS-AES256-GCM-SHA384",
+	"proxy.process.ssl.cipher.user_agent.DHE-DSS-AES256-SHA",
+	"proxy.process.ssl.cipher.user_agent.DHE-DSS-AES256-SHA256",
+	"proxy.process.ssl.cipher.user_agent.DHE-DSS-CAMELLIA128-SHA",
+	"proxy.process.ssl.cipher.user_agent.DHE-DSS-CAMELLIA256-SHA",
+	"proxy.process.ssl.cipher.user_agent.DHE-DSS-SEED-SHA",
+	"proxy.process.ssl.cipher.user_agent.DHE-RSA-AES128-GCM-SHA256",
+	"proxy.process.ssl.cipher.user_agent.DHE-RSA-AES128-SHA",
+	"proxy.process.ssl.cipher.user_agent.DHE-RSA-AES128-SHA256",
+	"proxy.process.ssl.cipher.user_agent.DHE-RSA-AES256-GCM-SHA384",
+	"proxy.process.ssl.cipher.user_agent.DHE-RSA-AES256-SHA",
+	"proxy.process.ssl.cipher.user_agent.DHE-RSA-AES256-SHA256",
+	"proxy.process.ssl.cipher.user_agent.DHE-RSA-CAMELLIA128-SHA",
+	"proxy.process.ssl.cipher.user_agent.DHE-RSA-CAMELLIA256-SHA",
+	"proxy.process.ssl.cipher.user_agent.DHE-RSA-SEED-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDH-ECDSA-AES128-GCM-SHA256",
+	"proxy.process.ssl.cipher.user_agent.ECDH-ECDSA-AES128-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDH-ECDSA-AES128-SHA256",
+	"proxy.process.ssl.cipher.user_agent.ECDH-ECDSA-AES256-GCM-SHA384",
+	"proxy.process.ssl.cipher.user_agent.ECDH-ECDSA-AES256-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDH-ECDSA-AES256-SHA384",
+	"proxy.process.ssl.cipher.user_agent.ECDH-ECDSA-DES-CBC3-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDH-ECDSA-RC4-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDH-RSA-AES128-GCM-SHA256",
+	"proxy.process.ssl.cipher.user_agent.ECDH-RSA-AES128-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDH-RSA-AES128-SHA256",
+	"proxy.process.ssl.cipher.user_agent.ECDH-RSA-AES256-GCM-SHA384",
+	"proxy.process.ssl.cipher.user_agent.ECDH-RSA-AES256-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDH-RSA-AES256-SHA384",
+	"proxy.process.ssl.cipher.user_agent.ECDH-RSA-DES-CBC3-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDH-RSA-RC4-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-ECDSA-AES128-GCM-SHA256",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-ECDSA-AES128-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-ECDSA-AES128-SHA256",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-ECDSA-AES256-GCM-SHA384",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-ECDSA-AES256-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-ECDSA-AES256-SHA384",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-ECDSA-DES-CBC3-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-ECDSA-RC4-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-RSA-AES128-GCM-SHA256",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-RSA-AES128-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-RSA-AES128-SHA256",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-RSA-AES256-GCM-SHA384",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-RSA-AES256-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-RSA-AES256-SHA384",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-RSA-DES-CBC3-SHA",
+	"proxy.process.ssl.cipher.user_agent.ECDHE-RSA-RC4-SHA",
+	"proxy.process.ssl.cipher.user_agent.EDH-DSS-DES-CBC3-SHA",
+	"proxy.process.ssl.cipher.user_agent.EDH-RSA-DES-CBC3-SHA",
+	"proxy.process.ssl.cipher.user_agent.IDEA-CBC-SHA",
+	"proxy.process.ssl.cipher.user_agent.KRB5-DES-CBC3-MD5",
+	"proxy.process.ssl.cipher.user_agent.KRB5-DES-CBC3-SHA",
+	"proxy.process.ssl.cipher.user_agent.KRB5-IDEA-CBC-MD5",
+	"proxy.process.ssl.cipher.user_agent.KRB5-IDEA-CBC-SHA",
+	"proxy.process.ssl.cipher.user_agent.KRB5-RC4-MD5",
+	"proxy.process.ssl.cipher.user_agent.KRB5-RC4-SHA",
+	"proxy.process.ssl.cipher.user_agent.PSK-3DES-EDE-CBC-SHA",
+	"proxy.process.ssl.cipher.user_agent.PSK-AES128-CBC-SHA",
+	"proxy.process.ssl.cipher.user_agent.PSK-AES256-CBC-SHA",
+	"proxy.process.ssl.cipher.user_agent.PSK-RC4-SHA",
+	"proxy.process.ssl.cipher.user_agent.RC4-MD5",
+	"proxy.process.ssl.cipher.user_agent.RC4-SHA",
+	"proxy.process.ssl.cipher.user_agent.SEED-SHA",
+	"proxy.process.ssl.origin_server_bad_cert",
+	"proxy.process.ssl.origin_server_cert_verify_failed",
+	"proxy.process.ssl.origin_server_decryption_failed",
+	"proxy.process.ssl.origin_server_expired_cert",
+	"proxy.process.ssl.origin_server_other_errors",
+	"proxy.process.ssl.origin_server_revoked_cert",
+	"proxy.process.ssl.origin_server_unknown_ca",
+	"proxy.process.ssl.origin_server_unknown_cert",
+	"proxy.process.ssl.origin_server_wrong_version",
+	"proxy.process.ssl.ssl_error_read_eos",
+	"proxy.process.ssl.ssl_error_ssl",
+	"proxy.process.ssl.ssl_error_syscall",
+	"proxy.process.ssl.ssl_error_want_read",
+	"proxy.process.ssl.ssl_error_want_write",
+	"proxy.process.ssl.ssl_error_want_x509_lookup",
+	"proxy.process.ssl.ssl_error_zero_return",
+	"proxy.process.ssl.ssl_ocsp_refresh_cert_failure",
+	"proxy.process.ssl.ssl_ocsp_refreshed_cert",
+	"proxy.process.ssl.ssl_ocsp_revoked_cert_stat",
+	"proxy.process.ssl.ssl_ocsp_unknown_cert_stat",
+	"proxy.process.ssl.ssl_session_cache_eviction",
+	"proxy.process.ssl.ssl_session_cache_hit",
+	"proxy.process.ssl.ssl_session_cache_lock_contention",
+	"proxy.process.ssl.ssl_session_cache_miss",
+	"proxy.process.ssl.ssl_session_cache_new_session",
+	"proxy.process.ssl.ssl_sni_name_set_failure",
+	"proxy.process.ssl.total_handshake_time",
+	"proxy.process.ssl.total_success_handshake_count",
+	"proxy.process.ssl.total_success_handshake_count_in",
+	"proxy.process.ssl.total_success_handshake_count_out",
+	"proxy.process.ssl.total_ticket_keys_renewed",
+	"proxy.process.ssl.total_tickets_created",
+	"proxy.process.ssl.total_tickets_not_found",
+	"proxy.process.ssl.total_tickets_renewed",
+	"proxy.process.ssl.total_tickets_verified",
+	"proxy.process.ssl.total_tickets_verified_old_key",
+	"proxy.process.ssl.user_agent_bad_cert",
+	"proxy.process.ssl.user_agent_cert_verify_failed",
+	"proxy.process.ssl.user_agent_decryption_failed",
+	"proxy.process.ssl.user_agent_expired_cert",
+	"proxy.process.ssl.user_agent_other_errors",
+	"proxy.process.ssl.user_agent_revoked_cert",
+	"proxy.process.ssl.user_agent_session_hit",
+	"proxy.process.ssl.user_agent_session_miss",
+	"proxy.process.ssl.user_agent_session_timeout",
+	"proxy.process.ssl.user_agent_sessions",
+	"proxy.process.ssl.user_agent_unknown_ca",
+	"proxy.process.ssl.user_agent_unknown_cert",
+	"proxy.process.ssl.user_agent_wrong_version",
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	sslCipherPrefix  = "proxy.process.ssl.cipher.user_agent."
+	sslOpenSSLPrefix = "proxy.process.ssl.ssl_error_"
+	sslRetryPrefix   = "proxy.process.ssl.ssl_error_want_"
+	sslRecordPrefix  = "proxy.process.ssl."
+	sslUnknownSide   = "unknown"
+)
+
+var (
+	sslSessionCacheLookups = prometheus.NewDesc(
+		"trafficserver_ssl_session_cache_lookups_total",
+		"Lookups in the ATS TLS session cache by result.",
+		[]string{"result"}, nil,
+	)
+	sslSessionCacheNewSessions = prometheus.NewDesc(
+		"trafficserver_ssl_session_cache_new_sessions_total",
+		"Sessions added to the ATS TLS session cache.",
+		nil, nil,
+	)
+	sslSessionCacheEvictions = prometheus.NewDesc(
+		"trafficserver_ssl_session_cache_evictions_total",
+		"Sessions evicted from the ATS TLS session cache.",
+		nil, nil,
+	)
+	sslSessionCacheLockContentions = prometheus.NewDesc(
+		"trafficserver_ssl_session_cache_lock_contentions_total",
+		"Lookups in the ATS TLS session cache that hit a locked bucket.",
+		nil, nil,
+	)
+	sslUserAgentSessionLookups = prometheus.NewDesc(
+		"trafficserver_ssl_user_agent_session_lookups_total",
+		"Lookups in the OpenSSL client session cache by result.",
+		[]string{"result"}, nil,
+	)
+	sslUserAgentSessions = prometheus.NewDesc(
+		"trafficserver_ssl_user_agent_sessions",
+		"Sessions in the OpenSSL client session cache.",
+		nil, nil,
+	)
+	sslTickets = prometheus.NewDesc(
+		"trafficserver_ssl_tickets_total",
+		"TLS session tickets by what happened to them.",
+		[]string{"result"}, nil,
+	)
+	sslTicketKeysRenewed = prometheus.NewDesc(
+		"trafficserver_ssl_ticket_keys_renewed_total",
+		"Session ticket key renewals.",
+		nil, nil,
+	)
+	sslResumptionRatio = prometheus.NewDesc(
+		"trafficserver_ssl_resumption_ratio",
+		"Share of session resumption attempts that succeeded since start, by mechanism. Only exported once there were attempts.",
+		[]string{"mechanism"}, nil,
+	)
+
+	sslCipherHandshakes = prometheus.NewDesc(
+		"trafficserver_ssl_cipher_handshakes_total",
+		"Successful client TLS handshakes by negotiated cipher.",
+		[]string{"cipher"}, nil,
+	)
+	sslErrors = prometheus.NewDesc(
+		"trafficserver_ssl_errors_total",
+		"TLS errors by side of the connection and reason. The low-level OpenSSL errors have side unknown.",
+		[]string{"side", "reason"}, nil,
+	)
+	sslRetries = prometheus.NewDesc(
+		"trafficserver_ssl_retries_total",
+		"TLS reads and writes OpenSSL asked to retry by what it was waiting for. These aren't errors, non-blocking sockets hit them all the time.",
+		[]string{"reason"}, nil,
+	)
+)
+
+// sslErrorSides maps the record prefix of each side of a connection to its
+// side label.
+var sslErrorSides = map[string]string{
+	"user_agent_":    "client",
+	"origin_server_": "origin",
+}
+
+// sslResumptions lists the hit and miss records of each session resumption
+// mechanism, for the resumption ratios.
+var sslResumptions = map[string][2]string{
+	"session_cache":  {"proxy.process.ssl.ssl_session_cache_hit", "proxy.process.ssl.ssl_session_cache_miss"},
+	"openssl_cache":  {"proxy.process.ssl.user_agent_session_hit", "proxy.process.ssl.user_agent_session_miss"},
+	"session_ticket": {"proxy.process.ssl.total_tickets_verified", "proxy.process.ssl.total_tickets_not_found"},
+}
+
+var sslErrorReasons = []string{
+	"other_errors",
+	"expired_cert",
+	"revoked_cert",
+	"unknown_cert",
+	"cert_verify_failed",
+	"bad_cert",
+	"decryption_failed",
+	"wrong_version",
+	"unknown_ca",
+}
+
+// collectSSLCiphers exports the per cipher records with the OpenSSL cipher
+// name as a label. Label values may contain any character, so unlike metric
+// names the cipher name is kept as is, dashes included.
+func collectSSLCiphers(records map[string]float64, ch chan<- prometheus.Metric) {
+	for record, value := range records {
+		if !strings.HasPrefix(record, sslCipherPrefix) {
+			continue
+		}
+		cipher := strings.TrimPrefix(record, sslCipherPrefix)
+		ch <- prometheus.MustNewConstMetric(sslCipherHandshakes, prometheus.CounterValue, value, cipher)
+		delete(records, record)
+	}
+}
+
+// collectSSLErrors folds the handshake errors of both sides of a connection
+// and the OpenSSL error counters into one labeled metric. The
+// SSL_ERROR_WANT_* counters are retries rather than errors and would swamp
+// the sum of the errors, so they go into a metric of their own.
+func collectSSLErrors(records map[string]float64, ch chan<- prometheus.Metric) {
+	for prefix, side := range sslErrorSides {
+		for _, reason := range sslErrorReasons {
+			record := sslRecordPrefix + prefix + reason
+			value, ok := records[record]
+			if !ok {
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(sslErrors, prometheus.CounterValue, value, side, reason)
+			delete(records, record)
+		}
+	}
+
+	for record, value := range records {
+		switch {
+		case strings.HasPrefix(record, sslRetryPrefix):
+			reason := "want_" + strings.TrimPrefix(record, sslRetryPrefix)
+			ch <- prometheus.MustNewConstMetric(sslRetries, prometheus.CounterValue, value, reason)
+		case strings.HasPrefix(record, sslOpenSSLPrefix):
+			reason := strings.TrimPrefix(record, sslRecordPrefix)
+			ch <- prometheus.MustNewConstMetric(sslErrors, prometheus.CounterValue, value, sslUnknownSide, reason)
+		default:
+			continue
+		}
+		delete(records, record)
+	}
+}
+
+// collectSSLSessions exports the session cache and session ticket records,
+// along with a resumption ratio for each mechanism.
+func collectSSLSessions(records map[string]float64, ch chan<- prometheus.Metric) {
+	for mechanism, r := range sslResumptions {
+		hits, hasHits := records[r[0]]
+		misses, hasMisses := records[r[1]]
+		if !hasHits || !hasMisses || hits+misses == 0 {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(sslResumptionRatio, prometheus.GaugeValue, hits/(hits+misses), mechanism)
+	}
+
+	collectLabeled(records, ch, sslSessionCacheLookups, prometheus.CounterValue, map[string]string{
+		"proxy.process.ssl.ssl_session_cache_hit":  "hit",
+		"proxy.process.ssl.ssl_session_cache_miss": "miss",
+	})
+	collectLabeled(records, ch, sslUserAgentSessionLookups, prometheus.CounterValue, map[string]string{
+		"proxy.process.ssl.user_agent_session_hit":     "hit",
+		"proxy.process.ssl.user_agent_session_miss":    "miss",
+		"proxy.process.ssl.user_agent_session_timeout": "timeout",
+	})
+	collectLabeled(records, ch, sslTickets, prometheus.CounterValue, map[string]string{
+		"proxy.process.ssl.total_tickets_created":          "created",
+		"proxy.process.ssl.total_tickets_verified":         "verified",
+		"proxy.process.ssl.total_tickets_not_found":        "not_found",
+		"proxy.process.ssl.total_tickets_renewed":          "renewed",
+		"proxy.process.ssl.total_tickets_verified_old_key": "verified_old_key",
+	})
+	collectSingle(records, ch, sslSessionCacheNewSessions, prometheus.CounterValue, "proxy.process.ssl.ssl_session_cache_new_session")
+	collectSingle(records, ch, sslSessionCacheEvictions, prometheus.CounterValue, "proxy.process.ssl.ssl_session_cache_eviction")
+	collectSingle(records, ch, sslSessionCacheLockContentions, prometheus.CounterValue, "proxy.process.ssl.ssl_session_cache_lock_contention")
+	collectSingle(records, ch, sslUserAgentSessions, prometheus.GaugeValue, "proxy.process.ssl.user_agent_sessions")
+	collectSingle(records, ch, sslTicketKeysRenewed, prometheus.CounterValue, "proxy.process.ssl.total_ticket_keys_renewed")
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestSSLCiphers(t *testing.T) {
+	families := scrapeFixture(t, "dynamic")
+
+	ciphers := labeledValues(families["trafficserver_ssl_cipher_handshakes_total"], "cipher")
+	if len(ciphers) != 95 {
+		t.Errorf("got %d ciphers, want 95", len(ciphers))
+	}
+	for _, cipher := range []string{"ECDHE-RSA-AES256-GCM-SHA384", "DES-CBC3-SHA"} {
+		if _, ok := ciphers[cipher]; !ok {
+			t.Errorf("cipher %s not exported verbatim", cipher)
+		}
+	}
+}
+
+func TestSSLErrors(t *testing.T) {
+	families := scrapeFixture(t, "dynamic")
+
+	mf, ok := families["trafficserver_ssl_errors_total"]
+	if !ok {
+		t.Fatal("TLS errors not exported")
+	}
+	errors := make(map[string]float64)
+	for _, m := range mf.GetMetric() {
+		var side, reason string
+		for _, lp := range m.GetLabel() {
+			switch lp.GetName() {
+			case "side":
+				side = lp.GetValue()
+			case "reason":
+				reason = lp.GetValue()
+			}
+		}
+		errors[side+"/"+reason] = m.GetCounter().GetValue()
+	}
+
+	if len(errors) != 22 {
+		t.Errorf("got %d errors, want 22", len(errors))
+	}
+	for key, want := range map[string]float64{
+		"client/expired_cert":           0,
+		"origin/unknown_ca":             0,
+		"unknown/ssl_error_syscall":     37,
+		"unknown/ssl_error_zero_return": 20,
+	} {
+		if got, ok := errors[key]; !ok || got != want {
+			t.Errorf("%s: got %g, want %g", key, got, want)
+		}
+	}
+	for key := range errors {
+		if strings.Contains(key, "want_") {
+			t.Errorf("retry %s counted as an error", key)
+		}
+	}
+
+	retries := labeledValues(families["trafficserver_ssl_retries_total"], "reason")
+	for reason, want := range map[string]float64{
+		"want_read":        0,
+		"want_write":       62365658,
+		"want_x509_lookup": 0,
+	} {
+		if got, ok := retries[reason]; !ok || got != want {
+			t.Errorf("retries %s: got %g, want %g", reason, got, want)
+		}
+	}
+	if len(retries) != 3 {
+		t.Errorf("got %d retry reasons, want 3", len(retries))
+	}
+}
+
+func TestSSLResumptionRatio(t *testing.T) {
+	records := map[string]float64{
+		"proxy.process.ssl.ssl_session_cache_hit":   3,
+		"proxy.process.ssl.ssl_session_cache_miss":  1,
+		"proxy.process.ssl.total_tickets_verified":  0,
+		"proxy.process.ssl.total_tickets_not_found": 0,
+	}
+	ch := make(chan prometheus.Metric, 10)
+	collectSSLSessions(records, ch)
+	close(ch)
+
+	ratios := make(map[string]float64)
+	for m := range ch {
+		if m.Desc() != sslResumptionRatio {
+			continue
+		}
+		var pb dto.Metric
+		if err := m.Write(&pb); err != nil {
+			t.Fatal(err)
+		}
+		ratios[pb.GetLabel()[0].GetValue()] = pb.GetGauge().GetValue()
+	}
+
+	if got := ratios["session_cache"]; got != 0.75 {
+		t.Errorf("got session cache ratio %g, want 0.75", got)
+	}
+	if _, ok := ratios["session_ticket"]; ok {
+		t.Error("got a ticket ratio without any ticket lookups")
+	}
+	if len(records) != 0 {
+		t.Errorf("session records were left over: %v", records)
+	}
+}
@@ -1,18 +1,22 @@
 package main
 
 import (
-	//"bytes"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
-	"io"
-	//"io/ioutil"
+	"io/ioutil"
+	"net"
 	"net/http"
 	"net/url"
-	//"os"
+	"os"
+	"os/signal"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -28,19 +32,112 @@ var (
 		"Was talking to Trafficserver successfully",
 		nil, nil,
 	)
+	scrapeDuration = prometheus.NewDesc(
+		"trafficserver_exporter_scrape_duration_seconds",
+		"Time it took to fetch and decode the stats.",
+		nil, nil,
+	)
+	lastScrapeError = prometheus.NewDesc(
+		"trafficserver_exporter_last_scrape_error",
+		"Whether the last scrape failed, by reason.",
+		[]string{"reason"}, nil,
+	)
+	responseSize = prometheus.NewDesc(
+		"trafficserver_exporter_response_size_bytes",
+		"Size of the stats response body of the last scrape, 0 if there was none.",
+		nil, nil,
+	)
+	recordsParsed = prometheus.NewDesc(
+		"trafficserver_exporter_records",
+		"Numeric records parsed from the stats response.",
+		nil, nil,
+	)
 	invalidChars = regexp.MustCompile("[^a-zA-Z0-9:_]")
 )
 
+// scrapeErrorReasons are the reason labels of
+// trafficserver_exporter_last_scrape_error.
+var scrapeErrorReasons = []string{"connect", "timeout", "http_status", "decode"}
+
+// TrafficServerCollector scrapes a single stats_over_http endpoint.
 type TrafficServerCollector struct {
+	URI    string
+	Module Module
+
+	scrapes     prometheus.Counter
+	parseErrors *prometheus.CounterVec
+
+	// Set by StartPolling, last and good are the last scrape and the last
+	// good one. Closing stop ends the polling, stopped is closed once it
+	// has.
+	polling    bool
+	maxAge     time.Duration
+	stop       chan struct{}
+	stopped    chan struct{}
+	mtx        sync.RWMutex
+	last, good *snapshot
+}
+
+// NewTrafficServerCollector validates the scrape URI and module up front so
+// that a typo fails at startup instead of reporting down forever.
+func NewTrafficServerCollector(uri string, module Module) (*TrafficServerCollector, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scrape URI %q: %s", uri, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid scrape URI %q: scheme must be http or https", uri)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid scrape URI %q: missing host", uri)
+	}
+	if err := module.validate(); err != nil {
+		return nil, err
+	}
+
+	return &TrafficServerCollector{
+		URI:    uri,
+		Module: module,
+		scrapes: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "trafficserver_exporter_scrapes_total",
+			Help: "Scrapes of the stats endpoint.",
+		}),
+		parseErrors: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "trafficserver_exporter_parse_errors_total",
+				Help: "Records skipped because their value isn't a number.",
+			},
+			[]string{"key"},
+		),
+	}, nil
 }
 
 type Metrics struct {
-	Counters Counters `json:"global"`
+	Global map[string]json.RawMessage `json:"global"`
+}
+
+// profiles maps each ATS version profile to the records it exports on their
+// own. The families and the curated naming table aren't limited by it. The
+// dynamic profile has no list and exports every numeric record, so new ATS
+// versions and plugin stats show up without code changes.
+var profiles = map[string]map[string]bool{
+	"7.1":     structRecords(Counters{}),
+	"dynamic": nil,
+}
+
+// structRecords returns the ATS record names from the json tags of a struct.
+func structRecords(v interface{}) map[string]bool {
+	records := make(map[string]bool)
+	t := reflect.TypeOf(v)
+	for i := 0; i < t.NumField(); i++ {
+		records[t.Field(i).Tag.Get("json")] = true
+	}
+	return records
 }
 
 // Very incomplete list of counters, but these are the ones we know we care
-// about right now. As we categorize and sort the metrics more, we'll bring
-// more Counters and Gauges over from the structs folder.
+// about right now. This makes up the 7.1 profile. Despite the name, some of
+// these are gauges, see catalog.go.
 type Counters struct {
 	Proxy_process_http_completed_requests                         float64 `json:"proxy.process.http.completed_requests"`
 	Proxy_process_http_total_incoming_connections                 float64 `json:"proxy.process.http.total_incoming_connections"`
@@ -168,89 +265,281 @@ type Counters struct {
 	Proxy_node_cache_bytes_total                                  float64 `json:"proxy.node.cache.bytes_total"`
 }
 
-func (c TrafficServerCollector) Describe(ch chan<- *prometheus.Desc) {
+// Describe sends the descriptors built at startup, so the registry catches
+// duplicate and inconsistent metrics when the collector is registered.
+func (c *TrafficServerCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- up
+	ch <- scrapeDuration
+	ch <- lastScrapeError
+	ch <- responseSize
+	ch <- recordsParsed
+	ch <- snapshotAge
+	ch <- c.scrapes.Desc()
+	c.parseErrors.Describe(ch)
+	ch <- buildInfo
+	if c.Module.Naming == namingLegacy {
+		legacyDescs.describe(ch)
+		return
+	}
+	for _, m := range curatedMetrics {
+		ch <- m.desc
+	}
+	for _, desc := range familyDescs {
+		ch <- desc
+	}
+	for _, h := range histograms {
+		ch <- h.desc
+	}
+	recordDescs.describe(ch)
+	cacheVolumeDescs.describe(ch)
 }
 
-func (c TrafficServerCollector) Collect(ch chan<- prometheus.Metric) {
+func (c *TrafficServerCollector) Collect(ch chan<- prometheus.Metric) {
+	last, good := c.snapshots()
 
-	// FIXME: need to make these arguments
-	var uri string = "http://localhost:8080/d6128c003f0179ad40d38cfc5a75b1e69b17145daaccfa02bf946983d2b6b9ea"
-	var sslVerify bool = true
-	var timeout = 5 * time.Second
-	_, err := url.Parse(uri)
+	ch <- c.scrapes
+	ch <- prometheus.MustNewConstMetric(scrapeDuration, prometheus.GaugeValue, last.duration.Seconds())
+	// From the last scrape, so that a body that fails to decode shows up.
+	ch <- prometheus.MustNewConstMetric(responseSize, prometheus.GaugeValue, float64(last.size))
+	for _, r := range scrapeErrorReasons {
+		failed := 0.0
+		if r == last.reason {
+			failed = 1
+		}
+		ch <- prometheus.MustNewConstMetric(lastScrapeError, prometheus.GaugeValue, failed, r)
+	}
 
-	if err != nil {
+	if good == nil {
 		ch <- prometheus.MustNewConstMetric(up, prometheus.GaugeValue, 0)
 		return
 	}
+	if c.polling {
+		age := time.Since(good.time)
+		ch <- prometheus.MustNewConstMetric(snapshotAge, prometheus.GaugeValue, age.Seconds())
+		if c.maxAge > 0 && age > c.maxAge {
+			ch <- prometheus.MustNewConstMetric(up, prometheus.GaugeValue, 0)
+			return
+		}
+	}
 
-	body, err := fetchHTTP(uri, sslVerify, timeout)
+	// This means things are healthy, so we can return an up
+	ch <- prometheus.MustNewConstMetric(up, prometheus.GaugeValue, 1)
+	ch <- prometheus.MustNewConstMetric(recordsParsed, prometheus.GaugeValue, float64(len(good.records)))
+	c.parseErrors.Collect(ch)
+
+	strs := good.strs
+	// The families below remove the records they export, so they work on a
+	// copy in case the snapshot is served again.
+	records := make(map[string]float64, len(good.records))
+	for record, value := range good.records {
+		records[record] = value
+	}
 
-	// print out the body for debug purposes
-	//buf, _ := ioutil.ReadAll(body)
-	//rdr1 := ioutil.NopCloser(bytes.NewBuffer(buf))
-	//log.Info(rdr1)
+	collectBuildInfo(strs, ch)
 
-	if err != nil {
-		ch <- prometheus.MustNewConstMetric(up, prometheus.GaugeValue, 0)
+	for record := range records {
+		if !c.Module.Metrics.Match(record) {
+			delete(records, record)
+		}
+	}
+
+	profile := profiles[c.Module.Version]
+	if c.Module.Naming == namingLegacy {
+		collectRecords(records, profile, true, ch)
 		return
 	}
 
-	decoder := json.NewDecoder(body)
+	// Each family removes the records it exports, the rest are exported
+	// one metric per record.
+	collectRestarts(records, ch)
+	collectHistograms(records, ch)
+	collectHTTPResponses(records, ch)
+	collectHTTPRequests(records, ch)
+	collectHTTPTransactions(records, ch)
+	collectMilestones(records, ch)
+	collectHTTP2(records, ch)
+	collectCacheResults(records, ch)
+	collectCacheVolumes(records, ch)
+	collectSSLCiphers(records, ch)
+	collectSSLErrors(records, ch)
+	collectSSLSessions(records, ch)
+	collectDNS(records, ch)
+	collectCurated(records, ch)
+	collectRecords(records, profile, false, ch)
+}
+
+// scrape fetches and decodes the stats. On failure it returns which of
+// scrapeErrorReasons went wrong.
+func (c *TrafficServerCollector) scrape() (*Metrics, int, string) {
+	body, err := fetchHTTP(c.URI, c.Module)
+	if err != nil {
+		log.Debugf("Error scraping %s: %s", c.URI, err)
+		if _, ok := err.(httpStatusError); ok {
+			return nil, 0, "http_status"
+		}
+		if e, ok := err.(net.Error); ok && e.Timeout() {
+			return nil, 0, "timeout"
+		}
+		return nil, 0, "connect"
+	}
+
 	var metrics Metrics
-	decode_err := decoder.Decode(&metrics)
-	if decode_err != nil {
-		ch <- prometheus.MustNewConstMetric(up, prometheus.GaugeValue, 0)
-		return
+	if err := json.Unmarshal(body, &metrics); err != nil {
+		log.Debugf("Error decoding stats from %s: %s", c.URI, err)
+		return nil, len(body), "decode"
 	}
+	return &metrics, len(body), ""
+}
 
-	// This means things are healthy, so we can return an up
-	ch <- prometheus.MustNewConstMetric(up, prometheus.GaugeValue, 1)
+// collectRecords exports each record in the profile as its own metric. A nil
+// profile exports all of them. Legacy records keep the name and value the
+// exporter always used, without the base unit.
+func collectRecords(records map[string]float64, profile map[string]bool, legacy bool, ch chan<- prometheus.Metric) {
+	// Sanitizing can map two records onto the same metric name, which would
+	// fail the whole scrape, so only the first one in sorted order is kept.
+	keys := make([]string, 0, len(records))
+	for record := range records {
+		keys = append(keys, record)
+	}
+	sort.Strings(keys)
+	seen := make(map[string]string, len(keys))
 
-	// deal with all of our counters
-	fields := reflect.TypeOf(metrics.Counters)
-	values := reflect.ValueOf(metrics.Counters)
-	num := fields.NumField()
+	for _, record := range keys {
+		if profile != nil && !profile[record] {
+			continue
+		}
+		name, descs, value := recordMetricName(record), recordDescs, baseValue(record, records[record])
+		if legacy {
+			name, descs, value = metricName(record), legacyDescs, records[record]
+		}
+		if other, ok := seen[name]; ok {
+			log.Debugf("Skipping %s, its metric name %s is already used by %s", record, name, other)
+			continue
+		}
+		seen[name] = record
+		ch <- prometheus.MustNewConstMetric(descs.get(record), recordType(record), value)
+	}
+}
 
-	for i := 0; i < num; i++ {
-		field := fields.Field(i)
-		name := strings.ToLower("trafficserver_" + invalidChars.ReplaceAllLiteralString(field.Name, "_"))
-		desc := prometheus.NewDesc(name, "Trafficserver metric "+field.Name, nil, nil)
-		value := values.Field(i)
-		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, float64(value.Float()))
+// collectLabeled exports the records in labels as one metric, using the
+// label value each record maps to.
+func collectLabeled(records map[string]float64, ch chan<- prometheus.Metric, desc *prometheus.Desc, valueType prometheus.ValueType, labels map[string]string) {
+	for record, label := range labels {
+		value, ok := records[record]
+		if !ok {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(desc, valueType, value, label)
+		delete(records, record)
+	}
+}
+
+// collectSingle exports one record under a curated name.
+func collectSingle(records map[string]float64, ch chan<- prometheus.Metric, desc *prometheus.Desc, valueType prometheus.ValueType, record string) {
+	value, ok := records[record]
+	if !ok {
+		return
 	}
+	ch <- prometheus.MustNewConstMetric(desc, valueType, value)
+	delete(records, record)
+}
+
+// recordMetricName is the metricName of a record with its base unit added.
+func recordMetricName(record string) string {
+	return unitName(metricName(record), record)
+}
+
+// metricName turns an ATS record name like proxy.process.http.completed_requests
+// into trafficserver_proxy_process_http_completed_requests.
+func metricName(record string) string {
+	return strings.ToLower("trafficserver_" + invalidChars.ReplaceAllLiteralString(record, "_"))
+}
+
+// httpStatusError is returned by fetchHTTP for non-2xx responses.
+type httpStatusError int
 
-	// do the same with the gauges, histograms, and summarys
-	// TODO - figure out what metrics are gauges and which are counters
+func (e httpStatusError) Error() string {
+	return fmt.Sprintf("HTTP status %d", int(e))
 }
 
-func fetchHTTP(uri string, sslVerify bool, timeout time.Duration) (io.Reader, error) {
-	tr := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: !sslVerify}}
+// fetchHTTP returns the whole response body, so that a timeout while reading
+// it is reported as a timeout rather than a decode error.
+func fetchHTTP(uri string, module Module) ([]byte, error) {
+	tlsConfig, err := newTLSConfig(module.TLSConfig)
+	if err != nil {
+		return nil, err
+	}
+	tr := &http.Transport{TLSClientConfig: tlsConfig}
+	defer tr.CloseIdleConnections()
 	client := http.Client{
-		Timeout:   timeout,
+		Timeout:   module.Timeout,
 		Transport: tr,
 	}
 
-	resp, err := client.Get(uri)
+	req, err := http.NewRequest("GET", uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range module.Headers {
+		req.Header.Set(name, value)
+	}
+	if module.BasicAuth != nil {
+		req.SetBasicAuth(module.BasicAuth.Username, module.BasicAuth.Password)
+	}
+
+	resp, err := client.Do(req)
 
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 	if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
-		resp.Body.Close()
-		return nil, fmt.Errorf("HTTP status %d", resp.StatusCode)
+		return nil, httpStatusError(resp.StatusCode)
 	}
-	return resp.Body, nil
+	return ioutil.ReadAll(resp.Body)
+}
+
+func newTLSConfig(cfg TLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+		ServerName:         cfg.ServerName,
+	}
+
+	if cfg.CAFile != "" {
+		ca, err := ioutil.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA file %q: %s", cfg.CAFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificates found in CA file %q", cfg.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
 }
 
 func main() {
 	var (
-		listenAddress = kingpin.Flag("web.listen-address", "Address to listen on for web interface and telemetry.").Default(":9548").String()
-		metricsPath   = kingpin.Flag("web.telemetry-path", "Path under which to expose metrics.").Default("/metrics").String()
-		//trafficServerScrapeURI    = kingpin.Flag("trafficserver.scrape-uri", "URI on which to scrape TrafficServer.").Default("http://localhost/stats").String()
-		//trafficServerSSLVerify    = kingpin.Flag("trafficserver.ssl-verify", "Flag that enables SSL certificate verification for the scrape URI").Default("true").Bool()
-		//trafficServerTimeout      = kingpin.Flag("trafficserver.timeout", "Timeout for trying to get stats from TrafficServer.").Default("5s").Duration()
+		listenAddress          = kingpin.Flag("web.listen-address", "Address to listen on for web interface and telemetry.").Default(":9548").String()
+		metricsPath            = kingpin.Flag("web.telemetry-path", "Path under which to expose metrics.").Default("/metrics").String()
+		trafficServerScrapeURI = kingpin.Flag("trafficserver.scrape-uri", "URI on which to scrape TrafficServer.").Default("http://localhost/stats").String()
+		trafficServerSSLVerify = kingpin.Flag("trafficserver.ssl-verify", "Flag that enables SSL certificate verification for the scrape URI").Default("true").Bool()
+		trafficServerTimeout   = kingpin.Flag("trafficserver.timeout", "Timeout for trying to get stats from TrafficServer.").Default("5s").Duration()
+		trafficServerVersion   = kingpin.Flag("trafficserver.version", "ATS version profile for the records no family exports: 7.1 for the hand-picked records or dynamic for every numeric record.").Default("7.1").String()
+		trafficServerPoll      = kingpin.Flag("trafficserver.poll-interval", "Scrape TrafficServer in the background at this interval and serve the last result, 0 scrapes on every request.").Default("0s").Duration()
+		trafficServerMaxAge    = kingpin.Flag("trafficserver.max-age", "Report TrafficServer as down once the last good background scrape is older than this, 0 for three poll intervals.").Default("0s").Duration()
+		metricsNaming          = kingpin.Flag("metrics.naming", "Metric naming scheme: curated for the Prometheus style names or legacy for the names derived from the record names.").Default(namingCurated).Enum(namingCurated, namingLegacy)
+		configFile             = kingpin.Flag("config.file", "Configuration file with named modules for /probe.").String()
 	)
 
 	log.AddFlags(kingpin.CommandLine)
@@ -261,19 +550,97 @@ func main() {
 	log.Infoln("Starting trafficserver_exporter", version.Info())
 	log.Infoln("Build context", version.BuildContext())
 
+	// The flags make up the default module, used for the local scrape and
+	// for probes that don't ask for a module from the config file.
+	scrapeURL, err := url.Parse(*trafficServerScrapeURI)
+	if err != nil {
+		log.Fatalf("Invalid scrape URI %q: %s", *trafficServerScrapeURI, err)
+	}
+	module := defaultModule
+	module.Path = scrapeURL.Path
+	module.Timeout = *trafficServerTimeout
+	module.TLSConfig.InsecureSkipVerify = !*trafficServerSSLVerify
+	module.Version = *trafficServerVersion
+	module.Naming = *metricsNaming
+
+	c, err := NewTrafficServerCollector(*trafficServerScrapeURI, module)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *trafficServerPoll > 0 {
+		maxAge := *trafficServerMaxAge
+		if maxAge == 0 {
+			maxAge = 3 * *trafficServerPoll
+		} else if maxAge < *trafficServerPoll {
+			log.Fatalf("--trafficserver.max-age %s is shorter than --trafficserver.poll-interval %s", maxAge, *trafficServerPoll)
+		}
+		c.StartPolling(*trafficServerPoll, maxAge)
+	}
+	prometheus.MustRegister(c)
+
+	sc := NewSafeConfig(map[string]Module{"default": module})
+	if *configFile != "" {
+		if err := sc.ReloadConfig(*configFile); err != nil {
+			log.Fatalf("Error loading config: %s", err)
+		}
+		log.Infoln("Loaded config file", *configFile)
+	}
+
+	reloadCh := make(chan chan error)
+	go func() {
+		hup := make(chan os.Signal, 1)
+		signal.Notify(hup, syscall.SIGHUP)
+		for {
+			select {
+			case <-hup:
+				if err := reloadConfig(sc, *configFile); err != nil {
+					log.Errorf("Error reloading config: %s", err)
+				}
+			case errCh := <-reloadCh:
+				errCh <- reloadConfig(sc, *configFile)
+			}
+		}
+	}()
+
 	log.Infoln("Listening on", *listenAddress)
 	http.Handle(*metricsPath, promhttp.Handler())
+	http.HandleFunc("/probe", func(w http.ResponseWriter, r *http.Request) {
+		probeHandler(w, r, sc)
+	})
+	http.HandleFunc("/-/reload", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			fmt.Fprintf(w, "This endpoint requires a POST request.\n")
+			return
+		}
+
+		errCh := make(chan error)
+		reloadCh <- errCh
+		if err := <-errCh; err != nil {
+			http.Error(w, fmt.Sprintf("failed to reload config: %s", err), http.StatusInternalServerError)
+		}
+	})
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte(`<html>
              <head><title>Trafficserver Exporter</title></head>
              <body>
              <h1>Trafficserver Exporter</h1>
              <p><a href='` + *metricsPath + `'>Metrics</a></p>
+             <p><a href='/probe?target=localhost:8080'>Probe localhost:8080</a></p>
              </body>
              </html>`))
 	})
 
-	c := TrafficServerCollector{}
-	prometheus.MustRegister(c)
 	log.Fatal(http.ListenAndServe(*listenAddress, nil))
 }
+
+func reloadConfig(sc *SafeConfig, file string) error {
+	if file == "" {
+		return fmt.Errorf("no config file given with --config.file")
+	}
+	if err := sc.ReloadConfig(file); err != nil {
+		return err
+	}
+	log.Infoln("Reloaded config file", file)
+	return nil
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestScrapeErrorReasons(t *testing.T) {
+	for reason, handler := range map[string]http.HandlerFunc{
+		"http_status": func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusForbidden)
+		},
+		"decode": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"global": {`))
+		},
+		"timeout": func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+		},
+		"connect": nil,
+	} {
+		ts := httptest.NewServer(handler)
+		uri := ts.URL
+		if handler == nil {
+			ts.Close()
+		} else {
+			defer ts.Close()
+		}
+
+		module := defaultModule
+		module.Timeout = 50 * time.Millisecond
+		c, err := NewTrafficServerCollector(uri, module)
+		if err != nil {
+			t.Fatal(err)
+		}
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(c)
+		mfs, err := registry.Gather()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, mf := range mfs {
+			switch mf.GetName() {
+			case "trafficserver_up":
+				if got := mf.GetMetric()[0].GetGauge().GetValue(); got != 0 {
+					t.Errorf("%s: got up %g, want 0", reason, got)
+				}
+			case "trafficserver_exporter_scrapes_total":
+				if got := mf.GetMetric()[0].GetCounter().GetValue(); got != 1 {
+					t.Errorf("%s: got %g scrapes, want 1", reason, got)
+				}
+			case "trafficserver_exporter_last_scrape_error":
+				errors := labeledValues(mf, "reason")
+				for _, r := range scrapeErrorReasons {
+					want := 0.0
+					if r == reason {
+						want = 1
+					}
+					if errors[r] != want {
+						t.Errorf("%s: got last scrape error %g for %s, want %g", reason, errors[r], r, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestNewTrafficServerCollector(t *testing.T) {
+	for uri, valid := range map[string]bool{
+		"http://localhost:8080/_stats":  true,
+		"https://ats.example.com/stats": true,
+		"localhost:8080/_stats":         false,
+		"ats.example.com/_stats":        false,
+		"ftp://ats.example.com/_stats":  false,
+		"unix:///var/run/ats.sock":      false,
+		"http:///_stats":                false,
+		"http://":                       false,
+	} {
+		_, err := NewTrafficServerCollector(uri, defaultModule)
+		if valid && err != nil {
+			t.Errorf("%s: unexpected error: %s", uri, err)
+		}
+		if !valid && err == nil {
+			t.Errorf("%s: expected an error", uri)
+		}
+	}
+}
+
+// fixtureRecords returns the numeric records of test/trafficserver.json.
+func fixtureRecords(t *testing.T) map[string]float64 {
+	data, err := ioutil.ReadFile("test/trafficserver.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m Metrics
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatal(err)
+	}
+	records, _, _ := parseRecords(m.Global)
+	return records
+}
+
+// collectRecordNames runs collectRecords with the legacy naming and returns
+// the exported values by metric name.
+func collectRecordNames(records map[string]float64, profile map[string]bool) map[string]float64 {
+	ch := make(chan prometheus.Metric, len(records))
+	collectRecords(records, profile, true, ch)
+	close(ch)
+
+	values := make(map[string]float64, len(ch))
+	for m := range ch {
+		var pb dto.Metric
+		m.Write(&pb)
+		name := strings.SplitN(m.Desc().String(), `"`, 3)[1]
+		values[name] = pb.GetGauge().GetValue() + pb.GetCounter().GetValue()
+	}
+	return values
+}
+
+func TestProfiles(t *testing.T) {
+	records := fixtureRecords(t)
+
+	got := collectRecordNames(records, profiles["dynamic"])
+	for record := range records {
+		if _, ok := got[metricName(record)]; !ok {
+			t.Errorf("dynamic: record %s not exported", record)
+		}
+	}
+	if len(got) != len(records) {
+		t.Errorf("dynamic: got %d metrics for %d records", len(got), len(records))
+	}
+
+	got = collectRecordNames(records, profiles["7.1"])
+	want := make(map[string]bool)
+	for record := range profiles["7.1"] {
+		if _, ok := records[record]; ok {
+			want[metricName(record)] = true
+		}
+	}
+	for name := range got {
+		if !want[name] {
+			t.Errorf("7.1: %s isn't in the Counters struct", name)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("7.1: got %d metrics, want %d", len(got), len(want))
+	}
+	if _, ok := got["trafficserver_proxy_process_http_completed_requests"]; !ok {
+		t.Error("7.1: completed_requests not exported")
+	}
+}
+
+func TestProfileFamilies(t *testing.T) {
+	// The profile only limits the records no family exports.
+	families := scrapeFixture(t, "7.1")
+	for _, name := range []string{
+		"trafficserver_ssl_cipher_handshakes_total",
+		"trafficserver_http_milestone_seconds_total",
+		"trafficserver_dns_lookups_total",
+		"trafficserver_http_completed_requests_total",
+	} {
+		if _, ok := families[name]; !ok {
+			t.Errorf("7.1: family %s not exported", name)
+		}
+	}
+	if _, ok := families["trafficserver_proxy_process_net_calls_to_write_total"]; ok {
+		t.Error("7.1: record outside the profile exported")
+	}
+
+	module := defaultModule
+	module.Naming = namingLegacy
+	families = scrapeModule(t, module)
+	if _, ok := families["trafficserver_proxy_process_net_calls_to_write"]; ok {
+		t.Error("7.1 legacy: record outside the profile exported")
+	}
+}
+
+func TestCollectRecordsCollisions(t *testing.T) {
+	got := collectRecordNames(map[string]float64{
+		"proxy.process.plugin.foo_bar": 2,
+		"proxy.process.plugin.foo-bar": 1,
+		"proxy.process.plugin.foo.bar": 3,
+		"proxy.process.plugin.baz":     4,
+	}, nil)
+
+	want := map[string]float64{
+		"trafficserver_proxy_process_plugin_foo_bar": 1,
+		"trafficserver_proxy_process_plugin_baz":     4,
+	}
+	if len(got) != len(want) {
+		t.Errorf("got %d metrics, want %d: %v", len(got), len(want), got)
+	}
+	for name, value := range want {
+		if got[name] != value {
+			t.Errorf("%s: got %g, want %g from the first record in sorted order", name, got[name], value)
+		}
+	}
+}